@@ -0,0 +1,111 @@
+package id3v2
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+const (
+	// apeTagIdentifier is the preamble of APEv1/APEv2 headers and footers.
+	apeTagIdentifier = "APETAGEX"
+
+	// apeFooterSize is the size of an APE tag header or footer in bytes.
+	apeFooterSize = 32
+
+	// apeFlagHasHeader is the flag in the APE footer telling that the tag contains a header.
+	apeFlagHasHeader = 1 << 31
+)
+
+// APETagInfo describes an APE tag found at the end of a file.
+// The library doesn't parse APE tags, but it keeps them intact when saving,
+// so callers can detect that mixed metadata exists in the file.
+type APETagInfo struct {
+	Version   uint32 // The APE tag version, e.g. 2000 for APEv2 and 1000 for APEv1.
+	Offset    int64  // The offset of the APE tag in the file, including the header if present.
+	Size      int64  // The total size of the APE tag in bytes, including the header and the footer.
+	ItemCount uint32 // The number of items in the APE tag.
+	HasHeader bool   // Whether the APE tag has a header in addition to the footer.
+}
+
+// DetectAPETag searches for an APE tag at the end of the data of size `size` read from `r`.
+// The APE tag may be followed by Lyrics3 and ID3v1 trailers.
+// The second return value reports whether an APE tag was found.
+func DetectAPETag(r io.ReaderAt, size int64) (APETagInfo, bool, error) {
+	// The APE footer may be at the very end or before the ID3v1 and Lyrics3 trailers.
+	candidates := []int64{size, size - id3v1TagSize}
+
+	start, err := trailersStart(r, size)
+	if err != nil {
+		return APETagInfo{}, false, err
+	}
+
+	candidates = append(candidates, start)
+
+	for _, end := range candidates {
+		info, found, err := apeTagEndingAt(r, end)
+		if err != nil || found {
+			return info, found, err
+		}
+	}
+
+	return APETagInfo{}, false, nil
+}
+
+// APETag detects an APE tag at the end of the file the tag was initialized with.
+// The second return value reports whether an APE tag was found.
+// The tag must be initialized with a reader that supports io.ReaderAt (e.g., a file).
+func (tag *Tag) APETag() (APETagInfo, bool, error) {
+	r, ok := tag.reader.(io.ReaderAt)
+	if !ok {
+		return APETagInfo{}, false, ErrNoFile
+	}
+
+	size, err := tag.sourceSize()
+	if err != nil {
+		return APETagInfo{}, false, err
+	}
+
+	return DetectAPETag(r, size)
+}
+
+// apeTagEndingAt checks whether an APE tag footer ends at `end` and describes the tag.
+func apeTagEndingAt(r io.ReaderAt, end int64) (APETagInfo, bool, error) {
+	if end < apeFooterSize {
+		return APETagInfo{}, false, nil
+	}
+
+	footer := make([]byte, apeFooterSize)
+	if _, err := r.ReadAt(footer, end-apeFooterSize); err != nil {
+		return APETagInfo{}, false, err
+	}
+
+	if string(footer[:len(apeTagIdentifier)]) != apeTagIdentifier {
+		return APETagInfo{}, false, nil
+	}
+
+	// The footer layout: identifier, version, tag size (items and footer), item count, flags, reserved.
+	info := APETagInfo{
+		Version:   binary.LittleEndian.Uint32(footer[8:12]),
+		Size:      int64(binary.LittleEndian.Uint32(footer[12:16])),
+		ItemCount: binary.LittleEndian.Uint32(footer[16:20]),
+		HasHeader: binary.LittleEndian.Uint32(footer[20:24])&apeFlagHasHeader != 0,
+	}
+
+	if info.HasHeader {
+		info.Size += apeFooterSize
+	}
+
+	info.Offset = end - info.Size
+	if info.Offset < 0 || info.Size < apeFooterSize {
+		return APETagInfo{}, false, nil
+	}
+
+	return info, true, nil
+}
+
+// endsWithAPEFooter reports whether an APE tag footer ends exactly at `end`.
+func endsWithAPEFooter(r io.ReaderAt, end int64) (bool, error) {
+	_, found, err := apeTagEndingAt(r, end)
+
+	return found, err
+}
@@ -0,0 +1,119 @@
+package id3v2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"testing"
+)
+
+// makeAPETag builds a minimal APEv2 tag with a header, a single item and a footer.
+func makeAPETag() []byte {
+	item := []byte{5, 0, 0, 0, 0, 0, 0, 0}
+	item = append(item, "Title\x00Hello"...)
+
+	block := func(isHeader bool) []byte {
+		b := make([]byte, apeFooterSize)
+		copy(b, apeTagIdentifier)
+		binary.LittleEndian.PutUint32(b[8:], 2000)
+		binary.LittleEndian.PutUint32(b[12:], uint32(len(item)+apeFooterSize))
+		binary.LittleEndian.PutUint32(b[16:], 1)
+
+		flags := uint32(apeFlagHasHeader)
+		if isHeader {
+			flags |= 1 << 29
+		}
+
+		binary.LittleEndian.PutUint32(b[20:], flags)
+
+		return b
+	}
+
+	return concat(block(true), item, block(false))
+}
+
+func TestDetectAPETag(t *testing.T) {
+	t.Parallel()
+
+	music := bytes.Repeat([]byte{0xFF}, 256)
+	ape := makeAPETag()
+
+	for name, data := range map[string][]byte{
+		"APE only":      concat(music, ape),
+		"APE and ID3v1": concat(music, ape, NewEmptyTag().id3v1Tag().bytes()),
+	} {
+		info, found, err := DetectAPETag(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatalf("%v: error while detecting APE tag: %v", name, err)
+		}
+
+		if !found {
+			t.Fatalf("%v: expected APE tag to be found", name)
+		}
+
+		if info.Offset != int64(len(music)) {
+			t.Errorf("%v: expected offset %v, got %v", name, len(music), info.Offset)
+		}
+
+		if info.Size != int64(len(ape)) {
+			t.Errorf("%v: expected size %v, got %v", name, len(ape), info.Size)
+		}
+
+		if info.Version != 2000 || info.ItemCount != 1 || !info.HasHeader {
+			t.Errorf("%v: unexpected APE tag info: %+v", name, info)
+		}
+	}
+
+	if _, found, _ := DetectAPETag(bytes.NewReader(music), int64(len(music))); found {
+		t.Error("Expected no APE tag to be found")
+	}
+}
+
+func TestSaveKeepsAPETag(t *testing.T) {
+	tmpFile, err := prepareTestFile("ape-*.mp3")
+	if err != nil {
+		t.Fatal("Error while preparing test file:", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	ape := makeAPETag()
+	if _, err = tmpFile.Write(ape); err != nil {
+		t.Fatal("Error while writing APE tag:", err)
+	}
+
+	tmpFile.Close()
+
+	tag, err := Open(tmpFile.Name(), parseOpts)
+	if err != nil {
+		t.Fatal("Error while opening mp3 file:", err)
+	}
+	defer tag.Close()
+
+	for _, opts := range []SaveOptions{{WriteID3v1: true}, {StripTrailers: true, WriteID3v1: true}} {
+		if err = tag.SaveWithOptions(opts); err != nil {
+			t.Fatal("Error while saving tag:", err)
+		}
+	}
+
+	info, found, err := tag.APETag()
+	if err != nil {
+		t.Fatal("Error while detecting APE tag:", err)
+	}
+
+	if !found {
+		t.Fatal("Expected APE tag to be kept after saving")
+	}
+
+	data, err := os.ReadFile(tmpFile.Name())
+	if err != nil {
+		t.Fatal("Error while reading file:", err)
+	}
+
+	if info.Offset+info.Size != int64(len(data)-id3v1TagSize) {
+		t.Errorf("Expected APE tag to end before ID3v1 tag, got %+v for file size %v", info, len(data))
+	}
+
+	if !bytes.Equal(data[info.Offset:info.Offset+info.Size], ape) {
+		t.Error("Expected APE tag to be kept intact")
+	}
+}
@@ -0,0 +1,120 @@
+package id3v2
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"strings"
+)
+
+// ChecksumAlgorithm is an algorithm of the audio checksums stored by tag.SetAudioChecksum.
+type ChecksumAlgorithm byte
+
+// Algorithms of audio checksums.
+const (
+	ChecksumCRC32  ChecksumAlgorithm = iota // CRC-32 (IEEE), fast, detects accidental corruption.
+	ChecksumSHA256                          // SHA-256, slower, also detects deliberate changes.
+)
+
+// Descriptions of the user-defined text frames (TXXX) storing the audio checksums
+// as lowercase hexadecimal strings.
+const (
+	AudioCRC32Description  = "AUDIO_CRC32"
+	AudioSHA256Description = "AUDIO_SHA256"
+)
+
+var (
+	// ErrNoAudioChecksum is returned by tag.VerifyAudioChecksum if the tag stores no audio checksum.
+	ErrNoAudioChecksum = errors.New("no audio checksum in tag")
+
+	// ErrAudioChecksumMismatch is returned by tag.VerifyAudioChecksum if the audio data
+	// doesn't match the stored checksum, i.e. it was corrupted or changed.
+	ErrAudioChecksumMismatch = errors.New("audio checksum mismatch")
+)
+
+// description returns the description of the user-defined text frame storing the checksum.
+func (algorithm ChecksumAlgorithm) description() string {
+	if algorithm == ChecksumSHA256 {
+		return AudioSHA256Description
+	}
+
+	return AudioCRC32Description
+}
+
+// newHash returns a new hash computing the checksum.
+func (algorithm ChecksumAlgorithm) newHash() hash.Hash {
+	if algorithm == ChecksumSHA256 {
+		return sha256.New()
+	}
+
+	return crc32.NewIEEE()
+}
+
+// AudioChecksum computes the checksum of the audio data that follows the tag, see tag.AudioReader,
+// and returns it as a lowercase hexadecimal string. Metadata changes don't change the checksum,
+// so it identifies the audio across library copies.
+func (tag *Tag) AudioChecksum(algorithm ChecksumAlgorithm) (string, error) {
+	h := algorithm.newHash()
+
+	if _, err := io.Copy(h, tag.AudioReader()); err != nil {
+		return "", fmt.Errorf("error by reading audio: %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// SetAudioChecksum computes the checksum of the audio data that follows the tag
+// and stores it in the user-defined text frame AUDIO_CRC32 or AUDIO_SHA256,
+// so corruption can be detected later with tag.VerifyAudioChecksum.
+// It returns ErrReadOnlyTag if the tag is read-only.
+func (tag *Tag) SetAudioChecksum(algorithm ChecksumAlgorithm) error {
+	if tag.rejectChange() {
+		return ErrReadOnlyTag
+	}
+
+	checksum, err := tag.AudioChecksum(algorithm)
+	if err != nil {
+		return err
+	}
+
+	tag.SetUserDefinedText(algorithm.description(), checksum)
+
+	return nil
+}
+
+// VerifyAudioChecksum computes the checksums of the audio data that follows the tag
+// and compares them with the checksums stored by tag.SetAudioChecksum.
+// It returns ErrNoAudioChecksum if the tag stores no checksum
+// and an error wrapping ErrAudioChecksumMismatch if any checksum doesn't match.
+func (tag *Tag) VerifyAudioChecksum() error {
+	verified := false
+
+	for _, algorithm := range []ChecksumAlgorithm{ChecksumCRC32, ChecksumSHA256} {
+		udtf, ok := tag.GetUserDefinedTextFrame(algorithm.description())
+		if !ok {
+			continue
+		}
+
+		checksum, err := tag.AudioChecksum(algorithm)
+		if err != nil {
+			return err
+		}
+
+		if !strings.EqualFold(strings.TrimSpace(udtf.Value), checksum) {
+			return fmt.Errorf("%w: %s is %s, stored %s", ErrAudioChecksumMismatch, algorithm.description(),
+				checksum, udtf.Value)
+		}
+
+		verified = true
+	}
+
+	if !verified {
+		return ErrNoAudioChecksum
+	}
+
+	return nil
+}
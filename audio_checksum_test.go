@@ -0,0 +1,65 @@
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"testing"
+)
+
+func TestAudioChecksum(t *testing.T) {
+	t.Parallel()
+
+	audio := makeMPEGFrames(10)
+
+	parse := func(tag *Tag, audio []byte) *Tag {
+		t.Helper()
+
+		data, err := tag.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		parsed, err := ParseReader(bytes.NewReader(concat(data, audio)), parseOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return parsed
+	}
+
+	source := NewEmptyTag()
+	source.SetTitle("Title")
+
+	tag := parse(source, audio)
+
+	if err := tag.VerifyAudioChecksum(); !errors.Is(err, ErrNoAudioChecksum) {
+		t.Errorf("Expected %v, got %v", ErrNoAudioChecksum, err)
+	}
+
+	for _, algorithm := range []ChecksumAlgorithm{ChecksumCRC32, ChecksumSHA256} {
+		if err := tag.SetAudioChecksum(algorithm); err != nil {
+			t.Fatal("Error while setting audio checksum:", err)
+		}
+	}
+
+	udtf, _ := tag.GetUserDefinedTextFrame(AudioCRC32Description)
+	if expected := fmt.Sprintf("%08x", crc32.ChecksumIEEE(audio)); udtf.Value != expected {
+		t.Errorf("Expected CRC-32 %v, got %v", expected, udtf.Value)
+	}
+
+	// The checksums survive metadata changes, but not audio changes.
+	tag.SetArtist("Artist")
+
+	if err := parse(tag, audio).VerifyAudioChecksum(); err != nil {
+		t.Errorf("Expected the checksums to match, got %v", err)
+	}
+
+	corrupted := bytes.Clone(audio)
+	corrupted[100] ^= 0xFF
+
+	if err := parse(tag, corrupted).VerifyAudioChecksum(); !errors.Is(err, ErrAudioChecksumMismatch) {
+		t.Errorf("Expected %v, got %v", ErrAudioChecksumMismatch, err)
+	}
+}
@@ -0,0 +1,426 @@
+package id3v2
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+)
+
+// audioScanLimit is the maximum number of bytes searched for the first MPEG audio frame after the tag.
+const audioScanLimit = 64 * 1024
+
+// ErrNoAudioFrame is returned when no valid MPEG audio frame is found after the tag.
+var ErrNoAudioFrame = errors.New("no MPEG audio frame found")
+
+// MPEGVersion represents the version of an MPEG audio stream.
+type MPEGVersion byte
+
+// Available MPEG audio versions.
+const (
+	MPEGVersion25 MPEGVersion = iota // MPEG 2.5, an unofficial extension for very low sample rates.
+	mpegVersionReserved
+	MPEGVersion2 // MPEG 2 (ISO/IEC 13818-3).
+	MPEGVersion1 // MPEG 1 (ISO/IEC 11172-3).
+)
+
+// String returns the human-readable MPEG version, e.g. "MPEG 1".
+func (v MPEGVersion) String() string {
+	switch v {
+	case MPEGVersion1:
+		return "MPEG 1"
+	case MPEGVersion2:
+		return "MPEG 2"
+	case MPEGVersion25:
+		return "MPEG 2.5"
+	default:
+		return "reserved"
+	}
+}
+
+// ChannelMode represents the channel mode of an MPEG audio stream.
+type ChannelMode byte
+
+// Available channel modes.
+const (
+	ChannelModeStereo      ChannelMode = iota // Stereo.
+	ChannelModeJointStereo                    // Joint stereo.
+	ChannelModeDualChannel                    // Two independent mono channels.
+	ChannelModeMono                           // Single channel.
+)
+
+// String returns the human-readable channel mode, e.g. "Joint stereo".
+func (cm ChannelMode) String() string {
+	switch cm {
+	case ChannelModeStereo:
+		return "Stereo"
+	case ChannelModeJointStereo:
+		return "Joint stereo"
+	case ChannelModeDualChannel:
+		return "Dual channel"
+	default:
+		return "Mono"
+	}
+}
+
+// AudioInfo describes the MPEG audio stream that follows the tag.
+type AudioInfo struct {
+	Version     MPEGVersion   // The MPEG version of the stream.
+	Layer       int           // The MPEG layer of the stream (1, 2 or 3).
+	Duration    time.Duration // The duration of the stream.
+	Bitrate     int           // The average bitrate in kbit/s.
+	SampleRate  int           // The sample rate in Hz.
+	ChannelMode ChannelMode   // The channel mode of the stream.
+	VBR         bool          // Whether the stream has a variable bitrate.
+	Frames      int64         // The number of audio frames, exact if a Xing/Info or VBRI header is present.
+	Offset      int64         // The offset of the first audio frame in the file.
+}
+
+// FrameDuration returns the duration of a single MPEG audio frame,
+// e.g. to convert SYLT timestamps between MPEG frames and milliseconds.
+// It returns 0 if the sample rate is unknown.
+func (info AudioInfo) FrameDuration() time.Duration {
+	if info.SampleRate <= 0 {
+		return 0
+	}
+
+	samples := mpegFrameHeader{version: info.Version, layer: info.Layer}.samplesPerFrame()
+
+	return time.Duration(samples) * time.Second / time.Duration(info.SampleRate)
+}
+
+// mpegBitrates contains bitrates in kbit/s indexed by [MPEG 1][layer - 1][bitrate index].
+var mpegBitrates = [2][3][15]int{
+	{ // MPEG 2 and 2.5.
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+	},
+	{ // MPEG 1.
+		{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+		{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+	},
+}
+
+// mpegSampleRates contains MPEG 1 sample rates in Hz. MPEG 2 uses a half and MPEG 2.5 a quarter of them.
+var mpegSampleRates = [3]int{44100, 48000, 32000}
+
+// mpegFrameHeader represents the parsed 4-byte header of an MPEG audio frame.
+type mpegFrameHeader struct {
+	version     MPEGVersion
+	layer       int
+	bitrate     int
+	sampleRate  int
+	padding     bool
+	channelMode ChannelMode
+}
+
+// parseMPEGFrameHeader parses the 4-byte MPEG audio frame header.
+// The second return value reports whether the header is valid.
+func parseMPEGFrameHeader(b []byte) (mpegFrameHeader, bool) {
+	var header mpegFrameHeader
+
+	// Every frame starts with 11 set sync bits.
+	if len(b) < 4 || b[0] != 0xFF || b[1]&0xE0 != 0xE0 {
+		return header, false
+	}
+
+	header.version = MPEGVersion((b[1] >> 3) & 0x03)
+	layerBits := (b[1] >> 1) & 0x03
+	bitrateIndex := b[2] >> 4
+	sampleRateIndex := (b[2] >> 2) & 0x03
+
+	// Reject reserved values and free-format streams.
+	if header.version == mpegVersionReserved || layerBits == 0 || bitrateIndex == 0 || bitrateIndex == 0x0F ||
+		sampleRateIndex == 0x03 {
+		return header, false
+	}
+
+	header.layer = 4 - int(layerBits)
+
+	isMPEG1 := 0
+	if header.version == MPEGVersion1 {
+		isMPEG1 = 1
+	}
+
+	header.bitrate = mpegBitrates[isMPEG1][header.layer-1][bitrateIndex]
+	header.sampleRate = mpegSampleRates[sampleRateIndex]
+
+	switch header.version {
+	case MPEGVersion2:
+		header.sampleRate /= 2
+	case MPEGVersion25:
+		header.sampleRate /= 4
+	}
+
+	header.padding = b[2]&0x02 != 0
+	header.channelMode = ChannelMode(b[3] >> 6)
+
+	return header, true
+}
+
+// samplesPerFrame returns the number of audio samples stored in a single frame.
+func (header mpegFrameHeader) samplesPerFrame() int {
+	switch {
+	case header.layer == 1:
+		return 384
+	case header.layer == 3 && header.version != MPEGVersion1:
+		return 576
+	default:
+		return 1152
+	}
+}
+
+// frameLength returns the length of the frame in bytes, including the header.
+func (header mpegFrameHeader) frameLength() int {
+	// Layer I uses 4-byte slots, the other layers use 1-byte slots.
+	slotSize := 1
+	if header.layer == 1 {
+		slotSize = 4
+	}
+
+	length := header.samplesPerFrame() / 8 * header.bitrate * 1000 / header.sampleRate / slotSize * slotSize
+	if header.padding {
+		length += slotSize
+	}
+
+	return length
+}
+
+// sideInfoSize returns the size of the Layer III side information that follows the frame header.
+// The Xing/Info header is located right after the side information.
+func (header mpegFrameHeader) sideInfoSize() int {
+	mono := header.channelMode == ChannelModeMono
+
+	switch {
+	case header.version == MPEGVersion1 && mono:
+		return 17
+	case header.version == MPEGVersion1:
+		return 32
+	case mono:
+		return 9
+	default:
+		return 17
+	}
+}
+
+// ReadAudioInfo reads the information about the MPEG audio stream
+// located between `offset` and `end` in `r`.
+// It reads the first frame header and the Xing/Info or VBRI header if present.
+// Without them, the stream is assumed to have a constant bitrate.
+func ReadAudioInfo(r io.ReaderAt, offset, end int64) (AudioInfo, error) {
+	// Read enough data to find the first frame and its Xing/VBRI header.
+	buf := make([]byte, min(audioScanLimit, max(end-offset, 0)))
+
+	n, err := r.ReadAt(buf, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return AudioInfo{}, err
+	}
+
+	buf = buf[:n]
+
+	position, header, ok := findMPEGFrame(buf)
+	if !ok {
+		return AudioInfo{}, ErrNoAudioFrame
+	}
+
+	info := AudioInfo{
+		Version:     header.version,
+		Layer:       header.layer,
+		Bitrate:     header.bitrate,
+		SampleRate:  header.sampleRate,
+		ChannelMode: header.channelMode,
+		Offset:      offset + int64(position),
+	}
+
+	audioSize := end - info.Offset
+	frame := buf[position:]
+
+	// Use the Xing/Info or VBRI header to get the exact number of frames and bytes.
+	frames, bytes, vbr, found := parseVBRHeader(frame, header)
+	if found && frames > 0 {
+		info.Frames = frames
+		info.VBR = vbr
+
+		if bytes > 0 {
+			audioSize = bytes
+		}
+
+		samples := frames * int64(header.samplesPerFrame())
+		info.Duration = durationOf(samples, int64(header.sampleRate))
+
+		// The bits per sample are scaled to the sample rate, so large streams don't overflow.
+		if samples > 0 {
+			info.Bitrate = int(audioSize * 8 * int64(header.sampleRate) / samples / 1000)
+		}
+
+		return info, nil
+	}
+
+	// Assume a constant bitrate stream.
+	info.Frames = audioSize / int64(header.frameLength())
+	info.Duration = durationOf(audioSize*8, int64(header.bitrate*1000))
+
+	return info, nil
+}
+
+// durationOf returns the duration of n units (e.g., samples or bits) consumed at rate units per second.
+// The whole seconds are computed first, so streams of any size don't overflow time.Duration.
+func durationOf(n, rate int64) time.Duration {
+	return time.Duration(n/rate)*time.Second + time.Duration(n%rate)*time.Second/time.Duration(rate)
+}
+
+// AudioInfo reads the information about the MPEG audio stream that follows the tag,
+// such as the duration, the average bitrate and the sample rate.
+// Trailing ID3v1, Lyrics3 and APE tags are excluded from the audio data.
+// The tag must be initialized with a reader that supports io.ReaderAt (e.g., a file).
+func (tag *Tag) AudioInfo() (AudioInfo, error) {
+	r, start, end, err := tag.audioRange()
+	if err != nil {
+		return AudioInfo{}, err
+	}
+
+	return ReadAudioInfo(r, start, end)
+}
+
+// AudioOffset returns the offset in the file of the music data that follows the parsed tag,
+// i.e. the end of the tag including its padding and any junk bytes before it.
+func (tag *Tag) AudioOffset() int64 {
+	return tag.offset + tag.originalSize
+}
+
+// AudioReader returns a reader of the music data that follows the parsed tag, so the audio can be hashed,
+// streamed or transcoded without reopening the file. Trailing ID3v1, Lyrics3 and APE tags are excluded.
+// The tag must be initialized with a reader that supports io.ReaderAt (e.g., a file),
+// otherwise reading fails with ErrNoFile. Reading fails with ErrNoAudioFrame for DSF and DFF files.
+// The returned reader is independent of the position of the tag's reader,
+// but it must be consumed before the tag is closed.
+func (tag *Tag) AudioReader() io.Reader {
+	r, start, end, err := tag.audioRange()
+	if err != nil {
+		return errorReader{err: err}
+	}
+
+	return io.NewSectionReader(r, start, end-start)
+}
+
+// audioRange returns the reader of the tag's file and the range of the MPEG audio data in it,
+// which starts after the tag and ends before the trailing ID3v1, Lyrics3 and APE tags.
+func (tag *Tag) audioRange() (io.ReaderAt, int64, int64, error) {
+	// DSD streams aren't MPEG audio.
+	if tag.container != ContainerMP3 {
+		return nil, 0, 0, ErrNoAudioFrame
+	}
+
+	r, ok := tag.reader.(io.ReaderAt)
+	if !ok {
+		return nil, 0, 0, ErrNoFile
+	}
+
+	size, err := tag.sourceSize()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	end, err := trailersStart(r, size)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	ape, found, err := DetectAPETag(r, size)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	if found {
+		end = min(end, ape.Offset)
+	}
+
+	start := tag.AudioOffset()
+
+	return r, start, max(end, start), nil
+}
+
+// errorReader is a reader that fails with the error.
+type errorReader struct {
+	err error
+}
+
+// Read returns the error of the reader.
+func (er errorReader) Read([]byte) (int, error) {
+	return 0, er.err
+}
+
+// findMPEGFrame searches the buffer for the first valid MPEG audio frame.
+// A frame is considered valid if the following frame header is valid too
+// or if the frame reaches the end of the buffer.
+func findMPEGFrame(buf []byte) (int, mpegFrameHeader, bool) {
+	for i := 0; i+4 <= len(buf); i++ {
+		header, ok := parseMPEGFrameHeader(buf[i:])
+		if !ok {
+			continue
+		}
+
+		next := i + header.frameLength()
+		if next+4 > len(buf) {
+			return i, header, true
+		}
+
+		if _, ok = parseMPEGFrameHeader(buf[next:]); ok {
+			return i, header, true
+		}
+	}
+
+	return 0, mpegFrameHeader{}, false
+}
+
+// parseVBRHeader parses the Xing/Info or VBRI header located in the first frame.
+// It returns the number of frames, the number of audio bytes,
+// whether the stream has a variable bitrate and whether a header was found.
+func parseVBRHeader(frame []byte, header mpegFrameHeader) (int64, int64, bool, bool) {
+	// The Xing/Info header follows the side information.
+	xingOffset := 4 + header.sideInfoSize()
+	if len(frame) >= xingOffset+8 {
+		id := string(frame[xingOffset : xingOffset+4])
+		if id == "Xing" || id == "Info" {
+			return parseXingHeader(frame[xingOffset:], id == "Xing")
+		}
+	}
+
+	// The VBRI header is always located 32 bytes after the frame header.
+	const vbriOffset = 4 + 32
+	if len(frame) >= vbriOffset+18 && string(frame[vbriOffset:vbriOffset+4]) == "VBRI" {
+		vbri := frame[vbriOffset:]
+		bytes := int64(binary.BigEndian.Uint32(vbri[10:14]))
+		frames := int64(binary.BigEndian.Uint32(vbri[14:18]))
+
+		return frames, bytes, true, true
+	}
+
+	return 0, 0, false, false
+}
+
+// parseXingHeader parses the Xing/Info header. The "Info" variant is written by encoders for CBR streams.
+func parseXingHeader(xing []byte, vbr bool) (int64, int64, bool, bool) {
+	const (
+		flagFrames = 0x01
+		flagBytes  = 0x02
+	)
+
+	flags := binary.BigEndian.Uint32(xing[4:8])
+	rest := xing[8:]
+
+	var frames, bytes int64
+
+	if flags&flagFrames != 0 && len(rest) >= 4 {
+		frames = int64(binary.BigEndian.Uint32(rest))
+		rest = rest[4:]
+	}
+
+	if flags&flagBytes != 0 && len(rest) >= 4 {
+		bytes = int64(binary.BigEndian.Uint32(rest))
+	}
+
+	return frames, bytes, vbr, true
+}
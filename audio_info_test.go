@@ -0,0 +1,227 @@
+package id3v2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// makeMPEGFrames builds `count` MPEG 1 Layer III frames at 128 kbit/s, 44100 Hz, stereo.
+func makeMPEGFrames(count int) []byte {
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0x00})
+
+	return bytes.Repeat(frame, count)
+}
+
+func TestReadAudioInfoCBR(t *testing.T) {
+	t.Parallel()
+
+	data := append([]byte{0, 0, 0}, makeMPEGFrames(100)...)
+
+	info, err := ReadAudioInfo(bytes.NewReader(data), 0, int64(len(data)))
+	if err != nil {
+		t.Fatal("Error while reading audio info:", err)
+	}
+
+	if info.Offset != 3 {
+		t.Errorf("Expected offset 3, got %v", info.Offset)
+	}
+
+	if info.Version != MPEGVersion1 || info.Layer != 3 || info.SampleRate != 44100 || info.Bitrate != 128 {
+		t.Errorf("Unexpected audio info: %+v", info)
+	}
+
+	if info.ChannelMode != ChannelModeStereo || info.VBR {
+		t.Errorf("Unexpected audio info: %+v", info)
+	}
+
+	if info.Frames != 100 {
+		t.Errorf("Expected 100 frames, got %v", info.Frames)
+	}
+
+	expectedDuration := time.Duration(41700*8) * time.Second / 128000
+	if info.Duration != expectedDuration {
+		t.Errorf("Expected duration %v, got %v", expectedDuration, info.Duration)
+	}
+}
+
+func TestReadAudioInfoXing(t *testing.T) {
+	t.Parallel()
+
+	data := makeMPEGFrames(10)
+
+	// The Xing header follows 32 bytes of side information in MPEG 1 stereo frames.
+	xing := data[4+32:]
+	copy(xing, "Xing")
+	binary.BigEndian.PutUint32(xing[4:], 0x03)
+	binary.BigEndian.PutUint32(xing[8:], 1000)
+	binary.BigEndian.PutUint32(xing[12:], 1000*417)
+
+	info, err := ReadAudioInfo(bytes.NewReader(data), 0, int64(len(data)))
+	if err != nil {
+		t.Fatal("Error while reading audio info:", err)
+	}
+
+	if !info.VBR {
+		t.Error("Expected VBR stream")
+	}
+
+	if info.Frames != 1000 {
+		t.Errorf("Expected 1000 frames, got %v", info.Frames)
+	}
+
+	expectedDuration := time.Duration(1000*1152) * time.Second / 44100
+	if info.Duration != expectedDuration {
+		t.Errorf("Expected duration %v, got %v", expectedDuration, info.Duration)
+	}
+}
+
+func TestReadAudioInfoLargeStream(t *testing.T) {
+	t.Parallel()
+
+	// 4 GB of audio at 128 kbit/s, only the beginning of the stream is read.
+	const audioSize = 4_000_000_000
+
+	data := makeMPEGFrames(10)
+
+	info, err := ReadAudioInfo(bytes.NewReader(data), 0, audioSize)
+	if err != nil {
+		t.Fatal("Error while reading audio info:", err)
+	}
+
+	if expected := 250_000 * time.Second; info.Duration != expected || info.Bitrate != 128 {
+		t.Errorf("Expected duration %v at 128 kbit/s, got %v at %d kbit/s", expected, info.Duration, info.Bitrate)
+	}
+
+	// The same stream described by a Xing header.
+	xing := data[4+32:]
+	copy(xing, "Xing")
+	binary.BigEndian.PutUint32(xing[4:], 0x03)
+	binary.BigEndian.PutUint32(xing[8:], 9_600_000)
+	binary.BigEndian.PutUint32(xing[12:], audioSize)
+
+	if info, err = ReadAudioInfo(bytes.NewReader(data), 0, audioSize); err != nil {
+		t.Fatal("Error while reading audio info:", err)
+	}
+
+	expected := time.Duration(9_600_000*1152/44100) * time.Second
+	if info.Duration.Truncate(time.Second) != expected || info.Bitrate != 127 {
+		t.Errorf("Expected duration %v at 127 kbit/s, got %v at %d kbit/s", expected, info.Duration, info.Bitrate)
+	}
+}
+
+func TestReadAudioInfoNoFrame(t *testing.T) {
+	t.Parallel()
+
+	data := bytes.Repeat([]byte{0x42}, 1024)
+
+	if _, err := ReadAudioInfo(bytes.NewReader(data), 0, int64(len(data))); !errors.Is(err, ErrNoAudioFrame) {
+		t.Errorf("Expected %v, got %v", ErrNoAudioFrame, err)
+	}
+}
+
+func TestTagAudioInfo(t *testing.T) {
+	tag, err := Open(mp3Path, Options{Parse: false})
+	if err != nil {
+		t.Fatal("Error while opening mp3 file:", err)
+	}
+	defer tag.Close()
+
+	info, err := tag.AudioInfo()
+	if err != nil {
+		t.Fatal("Error while reading audio info:", err)
+	}
+
+	if info.Offset != tag.originalSize {
+		t.Errorf("Expected offset %v, got %v", tag.originalSize, info.Offset)
+	}
+
+	if info.SampleRate != 44100 || info.Layer != 3 || info.Duration <= 0 {
+		t.Errorf("Unexpected audio info: %+v", info)
+	}
+}
+
+func TestTagAudioReader(t *testing.T) {
+	t.Parallel()
+
+	audio := makeMPEGFrames(10)
+
+	source := NewEmptyTag()
+	source.SetTitle("Title")
+
+	var buf bytes.Buffer
+	if _, err := source.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	tagSize := int64(buf.Len())
+
+	buf.Write(audio)
+	buf.Write(NewEmptyTag().id3v1Tag().bytes())
+
+	tag, err := ParseReader(bytes.NewReader(buf.Bytes()), parseOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if offset := tag.AudioOffset(); offset != tagSize {
+		t.Errorf("Expected offset %v, got %v", tagSize, offset)
+	}
+
+	data, err := io.ReadAll(tag.AudioReader())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(data, audio) {
+		t.Errorf("Expected %d bytes of audio without ID3v1 tag, got %d bytes", len(audio), len(data))
+	}
+
+	if _, err = io.ReadAll(NewEmptyTag().AudioReader()); !errors.Is(err, ErrNoFile) {
+		t.Errorf("Expected %v, got %v", ErrNoFile, err)
+	}
+}
+
+func TestSaveWithUpdateLength(t *testing.T) {
+	tmpFile, err := prepareTestFile("tlen-*.mp3")
+	if err != nil {
+		t.Fatal("Error while preparing test file:", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	tmpFile.Close()
+
+	tag, err := Open(tmpFile.Name(), parseOpts)
+	if err != nil {
+		t.Fatal("Error while opening mp3 file:", err)
+	}
+	defer tag.Close()
+
+	tag.AddTextFrame(tag.CommonID("Length"), EncodingUTF8, "1")
+
+	info, err := tag.AudioInfo()
+	if err != nil {
+		t.Fatal("Error while reading audio info:", err)
+	}
+
+	if err = tag.SaveWithOptions(SaveOptions{UpdateLength: true}); err != nil {
+		t.Fatal("Error while saving tag:", err)
+	}
+
+	parsed, err := Open(tmpFile.Name(), Options{Parse: true, ParseFrames: []string{"Length"}})
+	if err != nil {
+		t.Fatal("Error while opening mp3 file:", err)
+	}
+	defer parsed.Close()
+
+	expected := strconv.FormatInt(info.Duration.Milliseconds(), 10)
+	if got := parsed.GetTextFrame(parsed.CommonID("Length")).Text; got != expected {
+		t.Errorf("Expected length %v, got %v", expected, got)
+	}
+}
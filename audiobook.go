@@ -0,0 +1,73 @@
+package id3v2
+
+// Descriptions of the user-defined text frames (TXXX) used by audiobook managers and players.
+const (
+	NarratorDescription   = "NARRATOR"    // The narrator of the audiobook.
+	SeriesDescription     = "SERIES"      // The name of the series the audiobook belongs to.
+	SeriesPartDescription = "SERIES-PART" // The position of the audiobook in the series, e.g. "2" or "2.5".
+)
+
+// Narrator returns the narrator of the audiobook stored in the user-defined text frame NARRATOR,
+// falling back to the conductor/performer refinement (TPE3) written by older audiobook tools.
+func (tag *Tag) Narrator() string {
+	if udtf, ok := tag.GetUserDefinedTextFrame(NarratorDescription); ok {
+		return udtf.Value
+	}
+
+	return tag.GetTextFrame(tag.CommonID("Conductor/performer refinement")).Text
+}
+
+// SetNarrator sets the narrator of the audiobook in the user-defined text frame NARRATOR
+// and in the conductor/performer refinement (TPE3), which players without support for
+// user-defined text frames show. An empty narrator removes both frames.
+func (tag *Tag) SetNarrator(narrator string) {
+	tag.setAudiobookText(NarratorDescription, tag.CommonID("Conductor/performer refinement"), narrator)
+}
+
+// Series returns the name of the series the audiobook belongs to and its position in the series,
+// stored in the user-defined text frames SERIES and SERIES-PART. The name falls back
+// to the content group description (TIT1), which groups the books of a series in many players.
+func (tag *Tag) Series() (name, part string) {
+	if udtf, ok := tag.GetUserDefinedTextFrame(SeriesDescription); ok {
+		name = udtf.Value
+	} else {
+		name = tag.GetTextFrame(tag.CommonID("Content group description")).Text
+	}
+
+	if udtf, ok := tag.GetUserDefinedTextFrame(SeriesPartDescription); ok {
+		part = udtf.Value
+	}
+
+	return name, part
+}
+
+// SetSeries sets the name of the series the audiobook belongs to in the user-defined text frame SERIES
+// and in the content group description (TIT1), and its position in the series, e.g. "2" or "2.5",
+// in the user-defined text frame SERIES-PART. Empty values remove the corresponding frames.
+func (tag *Tag) SetSeries(name, part string) {
+	tag.setAudiobookText(SeriesDescription, tag.CommonID("Content group description"), name)
+	tag.SetUserDefinedText(SeriesPartDescription, nonEmpty(part)...)
+}
+
+// setAudiobookText sets the value in the user-defined text frame with the description
+// and in the text frame with the ID, or removes both if the value is empty.
+func (tag *Tag) setAudiobookText(description, id, value string) {
+	tag.SetUserDefinedText(description, nonEmpty(value)...)
+
+	if value == "" {
+		tag.DeleteFrames(id)
+
+		return
+	}
+
+	tag.AddTextFrame(id, tag.DefaultEncoding(), value)
+}
+
+// nonEmpty returns the value as a single-element slice, or nil if it's empty.
+func nonEmpty(value string) []string {
+	if value == "" {
+		return nil
+	}
+
+	return []string{value}
+}
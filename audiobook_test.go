@@ -0,0 +1,54 @@
+package id3v2
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAudiobookMetadata(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetNarrator("Stephen Fry")
+	tag.SetSeries("Harry Potter", "3")
+
+	var buf bytes.Buffer
+	if _, err := tag.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := ParseBytes(buf.Bytes(), parseOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if narrator := parsed.Narrator(); narrator != "Stephen Fry" {
+		t.Errorf("Expected %q, got %q", "Stephen Fry", narrator)
+	}
+
+	if name, part := parsed.Series(); name != "Harry Potter" || part != "3" {
+		t.Errorf("Expected %q and %q, got %q and %q", "Harry Potter", "3", name, part)
+	}
+
+	if group := parsed.GetTextFrame("TIT1").Text; group != "Harry Potter" {
+		t.Errorf("Expected content group %q, got %q", "Harry Potter", group)
+	}
+
+	// Tags written by older tools only have the standard frames.
+	parsed.DeleteFrames("TXXX")
+
+	if narrator := parsed.Narrator(); narrator != "Stephen Fry" {
+		t.Errorf("Expected %q from TPE3, got %q", "Stephen Fry", narrator)
+	}
+
+	if name, part := parsed.Series(); name != "Harry Potter" || part != "" {
+		t.Errorf("Expected %q from TIT1 without part, got %q and %q", "Harry Potter", name, part)
+	}
+
+	tag.SetNarrator("")
+	tag.SetSeries("", "")
+
+	if tag.HasFrames() {
+		t.Errorf("Expected all frames to be removed, got %v", tag.AllFrames())
+	}
+}
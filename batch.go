@@ -0,0 +1,39 @@
+package id3v2
+
+import (
+	"iter"
+	"runtime"
+	"sync"
+)
+
+// processConcurrently calls fn for every item produced by items using the given number of workers.
+// If workers is less than 1, the number of CPUs is used. It returns when all items are processed.
+func processConcurrently[T any](workers int, items iter.Seq[T], fn func(T)) {
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+
+	var (
+		wg    sync.WaitGroup
+		queue = make(chan T)
+	)
+
+	for range workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for item := range queue {
+				fn(item)
+			}
+		}()
+	}
+
+	for item := range items {
+		queue <- item
+	}
+
+	close(queue)
+	wg.Wait()
+}
@@ -11,6 +11,11 @@ import (
 type bufferedReader struct {
 	buf *bufio.Reader // The underlying buffered reader.
 	err error         // Stores the last error encountered during reading.
+
+	// legacyUTF16 determines whether the extra zero byte written by older versions of this library
+	// after big-endian UTF-16 strings is skipped before binary data as well. It's only enabled
+	// in lenient mode, as binary data following the string may start with a zero byte.
+	legacyUTF16 bool
 }
 
 // newBufferedReader creates and returns a new bufferedReader instance
@@ -82,6 +87,12 @@ func (br *bufferedReader) ReadByte() byte {
 	return b
 }
 
+// Byte reads a single byte like ReadByte.
+// Unlike ReadByte, its name doesn't clash with io.ByteReader, so it's exposed by FrameReader.
+func (br *bufferedReader) Byte() byte {
+	return br.ReadByte()
+}
+
 // Next returns the next n bytes from the buffer without consuming them.
 // If there are fewer than n bytes, it returns the entire buffer.
 // The returned slice is only valid until the next read or write operation.
@@ -182,32 +193,94 @@ func (br *bufferedReader) readTillDelimiters(delimiters []byte) ([]byte, error)
 // ReadText reads text from the buffer until the specified encoding's termination bytes are found.
 // It discards the termination bytes and returns the text.
 // This is useful for reading text fields in ID3v2 frames, which are often null-terminated.
+// The text is expected to be followed by another text field or the end of the frame,
+// use ReadTextBeforeData for text followed by binary data.
 func (br *bufferedReader) ReadText(encoding Encoding) []byte {
+	return br.readTextField(encoding, true)
+}
+
+// ReadTextBeforeData reads text like ReadText, but for text followed by binary data
+// (e.g., the description of a picture), which is never taken for a stray byte, except in lenient mode.
+func (br *bufferedReader) ReadTextBeforeData(encoding Encoding) []byte {
+	return br.readTextField(encoding, false)
+}
+
+// readTextField reads text like ReadText and skips the extra zero byte written by older versions
+// of this library after every big-endian UTF-16 string. The byte is skipped if another UTF-16 string
+// with a BOM follows it and, in lenient mode, unless it starts a zero code unit.
+func (br *bufferedReader) readTextField(encoding Encoding, textFollows bool) []byte {
 	if br.err != nil {
 		return nil
 	}
 
-	var (
-		text       []byte
-		delimiters = encoding.TerminationBytes
-	)
+	var text []byte
 
-	// Read until the termination bytes are found.
-	text, br.err = br.readTillDelimiters(delimiters)
+	text, br.err = br.readText(encoding)
 
-	// Handle UTF-16 encoding edge case: if the text doesn't start with a BOM,
-	// append the first byte to ensure proper decoding.
-	if encoding.Equals(EncodingUTF16) &&
-		!bytes.Equal(text, bom) {
-		text = append(text, br.ReadByte())
+	if br.err != nil || !encoding.Equals(EncodingUTF16) || !bytes.HasPrefix(text, bomBE) {
+		return text
 	}
 
-	// Discard the termination bytes.
-	br.Discard(len(delimiters))
+	next, _ := br.buf.Peek(3)
+
+	switch {
+	case textFollows && len(next) == 3 && next[0] == 0 && isBOM(next[1:]):
+		br.Discard(1)
+	case br.legacyUTF16 && len(next) > 0 && next[0] == 0 && (len(next) == 1 || next[1] != 0):
+		br.Discard(1)
+	}
 
 	return text
 }
 
+// readText reads bytes from the buffer until the termination bytes of the encoding are found
+// and discards them. It returns the data up to but not including the termination bytes.
+// The termination bytes of UTF-16 are only searched for at the boundaries of the code units,
+// so zero bytes of characters (e.g., "A" is 0x41 0x00 in little-endian) aren't taken for them.
+// If the data ends before the termination bytes, it returns all the data and the error,
+// including an odd trailing byte.
+func (br *bufferedReader) readText(encoding Encoding) ([]byte, error) {
+	if len(encoding.TerminationBytes) == 1 {
+		text, err := br.readTillDelimiter(encoding.TerminationBytes[0])
+		if err != nil {
+			return text, err
+		}
+
+		_, err = br.buf.Discard(1)
+
+		return text, err
+	}
+
+	text := make([]byte, 0)
+
+	for {
+		unit, err := br.buf.Peek(2)
+		if err != nil {
+			text = append(text, unit...)
+			_, _ = br.buf.Discard(len(unit))
+
+			return text, err
+		}
+
+		if unit[0] == 0 && unit[1] == 0 {
+			_, err = br.buf.Discard(2)
+
+			return text, err
+		}
+
+		text = append(text, unit...)
+
+		if _, err = br.buf.Discard(2); err != nil {
+			return text, err
+		}
+	}
+}
+
+// ReadString reads text like ReadText and decodes it from the encoding to a UTF-8 string.
+func (br *bufferedReader) ReadString(encoding Encoding) string {
+	return decodeText(br.ReadText(encoding), encoding)
+}
+
 // Reset resets the bufferedReader to read from a new io.Reader.
 // This is useful for reusing the bufferedReader with a different source.
 func (br *bufferedReader) Reset(rd io.Reader) {
@@ -9,9 +9,10 @@ import (
 // It wraps a bufio.Writer and tracks the number of bytes written, while also
 // handling errors gracefully to avoid unnecessary writes after an error occurs.
 type bufferedWriter struct {
-	err     error         // Stores the first error encountered during writing.
-	w       *bufio.Writer // Underlying buffered writer for efficient I/O.
-	written int           // Tracks the total number of bytes written so far.
+	err        error         // Stores the first error encountered during writing.
+	w          *bufio.Writer // Underlying buffered writer for efficient I/O.
+	written    int           // Tracks the total number of bytes written so far.
+	substitute bool          // Whether unencodable characters are substituted instead of failing.
 }
 
 // newBufferedWriter initializes a new bufferedWriter with the provided io.Writer.
@@ -28,9 +29,25 @@ func (bw *bufferedWriter) EncodeAndWriteText(src string, to Encoding) {
 		return // Skip if an error has already occurred.
 	}
 
+	if bw.substitute {
+		src = substituteUnencodable(src, to)
+	}
+
 	bw.err = encodeWriteText(bw, src, to) // Encode and write the text.
 }
 
+// EncodeAndWriteValues encodes the values using the specified encoding and writes them
+// separated by the termination bytes of the encoding, so every value in UTF-16 has its own BOM.
+func (bw *bufferedWriter) EncodeAndWriteValues(values []string, to Encoding) {
+	for i, value := range values {
+		if i > 0 {
+			_, _ = bw.Write(to.TerminationBytes)
+		}
+
+		bw.EncodeAndWriteText(value, to)
+	}
+}
+
 // Flush flushes any buffered data to the underlying writer.
 // If an error occurred during any previous write operation, it is returned.
 func (bw *bufferedWriter) Flush() error {
@@ -46,6 +63,7 @@ func (bw *bufferedWriter) Flush() error {
 func (bw *bufferedWriter) Reset(w io.Writer) {
 	bw.err = nil
 	bw.written = 0
+	bw.substitute = false
 
 	bw.w.Reset(w) // Reset the underlying bufio.Writer.
 }
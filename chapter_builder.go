@@ -0,0 +1,151 @@
+package id3v2
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// ErrInvalidChapterSpec is returned by BuildChapters when a chapter has an invalid time range.
+var ErrInvalidChapterSpec = errors.New("invalid chapter spec")
+
+// ChapterSpec describes a chapter passed to BuildChapters.
+type ChapterSpec struct {
+	Title string        // The title of the chapter, optional.
+	Start time.Duration // The start time of the chapter.
+	End   time.Duration // The end time of the chapter. If zero, the chapter ends where the next one starts.
+}
+
+// ChapterList holds chapter frames and the top-level table of contents listing them.
+type ChapterList struct {
+	Chapters        []ChapterFrame
+	TableOfContents TableOfContentsFrame
+}
+
+// BuildChapters creates chapter frames from the specs, sorted by start time,
+// with sequential element IDs ("chp1", "chp2", ...) and an ordered top-level table of contents.
+// The last chapter must have an end time, as there is no next chapter to end at.
+// It returns ErrInvalidChapterSpec if a chapter starts before zero or ends before it starts.
+func BuildChapters(specs []ChapterSpec) (ChapterList, error) {
+	specs = slices.Clone(specs)
+	slices.SortStableFunc(specs, func(a, b ChapterSpec) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	result := ChapterList{
+		TableOfContents: TableOfContentsFrame{
+			ElementID: defaultTableOfContentsElementID,
+			TopLevel:  true,
+			Ordered:   true,
+		},
+	}
+
+	for i, spec := range specs {
+		end := spec.End
+		if end == 0 && i+1 < len(specs) {
+			end = specs[i+1].Start
+		}
+
+		if spec.Start < 0 || end < spec.Start {
+			return ChapterList{}, fmt.Errorf("%w: chapter %d spans from %s to %s", ErrInvalidChapterSpec, i+1, spec.Start, end)
+		}
+
+		chapter := ChapterFrame{
+			ElementID:   defaultChapterElementIDPrefix + strconv.Itoa(i+1),
+			StartTime:   spec.Start,
+			EndTime:     end,
+			StartOffset: IgnoredOffset,
+			EndOffset:   IgnoredOffset,
+		}
+
+		if spec.Title != "" {
+			chapter.Title = &TextFrame{Encoding: EncodingUTF8, Text: spec.Title}
+		}
+
+		result.Chapters = append(result.Chapters, chapter)
+		result.TableOfContents.ChildElementIDs = append(result.TableOfContents.ChildElementIDs, chapter.ElementID)
+	}
+
+	return result, nil
+}
+
+// AddToTag adds the chapters and the table of contents to the tag.
+func (cl ChapterList) AddToTag(tag *Tag) {
+	for _, chapter := range cl.Chapters {
+		tag.AddChapterFrame(chapter)
+	}
+
+	tag.AddTableOfContentsFrame(cl.TableOfContents)
+}
+
+// RegenerateTOC sorts the chapters by start time, renumbers their element IDs ("chp1", "chp2", ...)
+// and rebuilds the top-level table of contents to list all chapters in order.
+// The element ID, title and description of an existing top-level table of contents are kept.
+// Child element IDs of nested tables of contents are renamed accordingly,
+// and IDs of chapters that no longer exist are removed from them.
+// It's useful after inserting or deleting chapters.
+func (tag *Tag) RegenerateTOC() {
+	chapters := tag.Chapters()
+
+	top := TableOfContentsFrame{
+		ElementID: defaultTableOfContentsElementID,
+		TopLevel:  true,
+		Ordered:   true,
+	}
+
+	var nested []TableOfContentsFrame
+
+	tocID := tag.CommonID("Table of contents")
+
+	for _, f := range tag.GetFrames(tocID) {
+		tocf, ok := f.(TableOfContentsFrame)
+		if !ok {
+			continue
+		}
+
+		if tocf.TopLevel {
+			top.ElementID, top.Title, top.Description = tocf.ElementID, tocf.Title, tocf.Description
+		} else {
+			nested = append(nested, tocf)
+		}
+	}
+
+	tag.DeleteFrames(tag.CommonID("Chapters"))
+	tag.DeleteFrames(tocID)
+
+	renamed := make(map[string]string, len(chapters))
+
+	for i, cf := range chapters {
+		id := defaultChapterElementIDPrefix + strconv.Itoa(i+1)
+		renamed[cf.ElementID] = id
+		cf.ElementID = id
+
+		tag.AddChapterFrame(cf)
+		top.ChildElementIDs = append(top.ChildElementIDs, id)
+	}
+
+	tag.AddTableOfContentsFrame(top)
+
+	nestedIDs := make(map[string]bool, len(nested))
+	for _, tocf := range nested {
+		nestedIDs[tocf.ElementID] = true
+	}
+
+	for _, tocf := range nested {
+		children := make([]string, 0, len(tocf.ChildElementIDs))
+
+		for _, child := range tocf.ChildElementIDs {
+			if id, ok := renamed[child]; ok {
+				children = append(children, id)
+			} else if nestedIDs[child] {
+				children = append(children, child)
+			}
+		}
+
+		tocf.ChildElementIDs = children
+		tag.AddTableOfContentsFrame(tocf)
+	}
+}
@@ -0,0 +1,109 @@
+package id3v2
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBuildChapters(t *testing.T) {
+	t.Parallel()
+
+	list, err := BuildChapters([]ChapterSpec{
+		{Title: "Outro", Start: 90 * time.Second, End: 100 * time.Second},
+		{Title: "Intro", Start: 0},
+		{Title: "Main", Start: 10 * time.Second, End: 80 * time.Second},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		id, title  string
+		start, end time.Duration
+	}{
+		{"chp1", "Intro", 0, 10 * time.Second},
+		{"chp2", "Main", 10 * time.Second, 80 * time.Second},
+		{"chp3", "Outro", 90 * time.Second, 100 * time.Second},
+	}
+
+	if len(list.Chapters) != len(expected) {
+		t.Fatalf("Expected %v chapters, got %v", len(expected), len(list.Chapters))
+	}
+
+	for i, e := range expected {
+		cf := list.Chapters[i]
+		if cf.ElementID != e.id || cf.Title.Text != e.title || cf.StartTime != e.start || cf.EndTime != e.end {
+			t.Errorf("Expected %v %v %v-%v, got %v %v %v-%v",
+				e.id, e.title, e.start, e.end, cf.ElementID, cf.Title.Text, cf.StartTime, cf.EndTime)
+		}
+	}
+
+	expectedIDs := []string{"chp1", "chp2", "chp3"}
+	if !slices.Equal(list.TableOfContents.ChildElementIDs, expectedIDs) {
+		t.Errorf("Expected child element IDs %v, got %v", expectedIDs, list.TableOfContents.ChildElementIDs)
+	}
+
+	tag := NewEmptyTag()
+	list.AddToTag(tag)
+
+	if count := len(tag.GetFrames(tag.CommonID("Chapters"))); count != 3 {
+		t.Errorf("Expected %v chapter frames, got %v", 3, count)
+	}
+}
+
+func TestBuildChaptersInvalidSpec(t *testing.T) {
+	t.Parallel()
+
+	_, err := BuildChapters([]ChapterSpec{{Start: 10 * time.Second, End: 5 * time.Second}})
+	if !errors.Is(err, ErrInvalidChapterSpec) {
+		t.Errorf("Expected %v, got %v", ErrInvalidChapterSpec, err)
+	}
+}
+
+func TestRegenerateTOC(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.AddChapterFrame(ChapterFrame{ElementID: "b", StartTime: 20 * time.Second, EndTime: 30 * time.Second})
+	tag.AddChapterFrame(ChapterFrame{ElementID: "a", StartTime: 0, EndTime: 10 * time.Second})
+	tag.AddChapterFrame(ChapterFrame{ElementID: "inserted", StartTime: 10 * time.Second, EndTime: 20 * time.Second})
+	tag.AddTableOfContentsFrame(TableOfContentsFrame{
+		ElementID:       "main",
+		TopLevel:        true,
+		ChildElementIDs: []string{"a", "b", "deleted"},
+		Title:           &TextFrame{Encoding: EncodingUTF8, Text: "Contents"},
+	})
+	tag.AddTableOfContentsFrame(TableOfContentsFrame{ElementID: "part", ChildElementIDs: []string{"b", "deleted"}})
+
+	tag.RegenerateTOC()
+
+	chapters := tag.Chapters()
+	for i, id := range []string{"chp1", "chp2", "chp3"} {
+		if chapters[i].ElementID != id {
+			t.Errorf("Expected %v, got %v", id, chapters[i].ElementID)
+		}
+	}
+
+	if chapters[1].StartTime != 10*time.Second {
+		t.Errorf("Expected inserted chapter to be second, got start time %v", chapters[1].StartTime)
+	}
+
+	for _, f := range tag.GetFrames(tag.CommonID("Table of contents")) {
+		tocf, _ := f.(TableOfContentsFrame)
+
+		switch tocf.ElementID {
+		case "main":
+			if !slices.Equal(tocf.ChildElementIDs, []string{"chp1", "chp2", "chp3"}) || tocf.Title.Text != "Contents" {
+				t.Errorf("Unexpected top-level table of contents: %+v", tocf)
+			}
+		case "part":
+			if !slices.Equal(tocf.ChildElementIDs, []string{"chp3"}) {
+				t.Errorf("Expected %v, got %v", []string{"chp3"}, tocf.ChildElementIDs)
+			}
+		default:
+			t.Errorf("Unexpected table of contents %v", tocf.ElementID)
+		}
+	}
+}
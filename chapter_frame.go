@@ -13,11 +13,18 @@ const (
 
 	// Number of nanoseconds in a millisecond.
 	nanosInMillis = 1000000
+
+	// chapterLinkFrameID is the ID of the subframe holding the link of a chapter.
+	chapterLinkFrameID = "WXXX"
+
+	// chapterArtworkFrameID is the ID of the subframe holding the artwork of a chapter.
+	chapterArtworkFrameID = "APIC"
 )
 
 // ChapterFrame represents a chapter frame in an ID3v2 tag,
 // as defined by the ID3v2 chapters specification here - // according to spec from http://id3.org/id3v2-chapters-1.0.
-// It supports a single TIT2 subframe (Title field) and ignores other subframes.
+// It supports TIT2 (Title field), TIT3 (Description field), WXXX (Link field) and APIC (Artwork field)
+// subframes and ignores other subframes. Absent subframes are nil.
 // If StartOffset or EndOffset equals IgnoredOffset,
 // the corresponding time (StartTime or EndTime) should be used instead.
 type ChapterFrame struct {
@@ -46,6 +53,14 @@ func (cf ChapterFrame) Size() int {
 		size += frameHeaderSize + cf.Description.Size() // Add size of the Description frame.
 	}
 
+	if cf.Link != nil {
+		size += frameHeaderSize + cf.Link.Size() // Add size of the Link frame.
+	}
+
+	if cf.Artwork != nil {
+		size += frameHeaderSize + cf.Artwork.Size() // Add size of the Artwork frame.
+	}
+
 	return size
 }
 
@@ -99,6 +114,22 @@ func (cf ChapterFrame) WriteTo(w io.Writer) (n int64, err error) {
 			}
 		}
 
+		// Write the Link frame if it exists.
+		if cf.Link != nil {
+			err = writeFrame(bw, chapterLinkFrameID, *cf.Link, true)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Write the Artwork frame if it exists.
+		if cf.Artwork != nil {
+			err = writeFrame(bw, chapterArtworkFrameID, *cf.Artwork, true)
+			if err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 }
@@ -131,14 +162,16 @@ func parseChapterFrame(br *bufferedReader, version byte) (Framer, error) {
 		return nil, err
 	}
 
-	var (
-		title       TextFrame
-		description TextFrame
-		link        LinkFrame
-		artwork     PictureFrame
-		buf         = getByteSlice(defaultBufferSize)
-	)
+	// Construct the ChapterFrame.
+	cf := ChapterFrame{
+		ElementID:   string(elementID),
+		StartTime:   time.Duration(int64(startTime) * nanosInMillis), // Convert milliseconds to nanoseconds.
+		EndTime:     time.Duration(int64(endTime) * nanosInMillis),
+		StartOffset: startOffset,
+		EndOffset:   endOffset,
+	}
 
+	buf := getByteSlice(defaultBufferSize)
 	defer putByteSlice(buf) // Return the buffer to the pool when done.
 
 	// Parse subframes until the end of the chapter frame.
@@ -152,80 +185,59 @@ func parseChapterFrame(br *bufferedReader, version byte) (Framer, error) {
 			return nil, err
 		}
 
-		id, bodySize := header.ID, header.BodySize
-
-		// Handle Title and Description subframes.
-		if id == TitleFrameID || id == SubtitleRefinementFrameID {
-			bodyReader := getLimitedReader(br, bodySize)
-			frameReaderReader := newBufferedReader(bodyReader)
-
-			var frame Framer
+		bodyReader := getLimitedReader(br, header.BodySize)
 
-			frame, err = parseTextFrame(frameReaderReader)
-			if err != nil {
-				putLimitedReader(bodyReader)
-
-				return nil, err
-			}
-
-			if id == TitleFrameID {
-				title, _ = frame.(TextFrame)
-			} else if id == SubtitleRefinementFrameID {
-				description, _ = frame.(TextFrame)
-			}
-
-			putLimitedReader(bodyReader)
+		err = cf.parseSubframe(header.ID, newBufferedReader(bodyReader), version)
+		if err == nil {
+			// Skip the rest of the subframe, e.g. if it isn't supported.
+			err = skipReaderBuf(bodyReader, buf)
 		}
 
-		// Handle Link subframes.
-		if id == "WXXX" {
-			bodyReader := getLimitedReader(br, bodySize)
-			br = newBufferedReader(bodyReader)
-
-			//nolint:govet // Shadowing is not an issue here since we return on error.
-			frame, err := parseLinkFrame(br)
-			if err != nil {
-				putLimitedReader(bodyReader)
-
-				return nil, err
-			}
-
-			link, _ = frame.(LinkFrame)
+		putLimitedReader(bodyReader)
 
-			putLimitedReader(bodyReader)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		// Handle Artwork subframes.
-		if id == "APIC" {
-			bodyReader := getLimitedReader(br, bodySize)
-			br = newBufferedReader(bodyReader)
-
-			//nolint:govet // Shadowing is not an issue here since we return on error.
-			frame, err := parsePictureFrame(br, version)
-			if err != nil {
-				putLimitedReader(bodyReader)
+	return cf, nil
+}
 
-				return nil, err
-			}
+// parseSubframe parses a supported subframe with the given ID and stores it in the chapter frame.
+// Unsupported subframes are ignored.
+func (cf *ChapterFrame) parseSubframe(id string, br *bufferedReader, version byte) error {
+	var (
+		frame Framer
+		err   error
+	)
 
-			artwork, _ = frame.(PictureFrame)
+	switch id {
+	case TitleFrameID, SubtitleRefinementFrameID:
+		frame, err = parseTextFrame(br)
+	case chapterLinkFrameID:
+		frame, err = parseLinkFrame(br)
+	case chapterArtworkFrameID:
+		frame, err = parsePictureFrame(br, version)
+	default:
+		return nil
+	}
 
-			putLimitedReader(bodyReader)
-		}
+	if err != nil {
+		return err
 	}
 
-	// Construct and return the ChapterFrame.
-	cf := ChapterFrame{
-		ElementID:   string(elementID),
-		StartTime:   time.Duration(int64(startTime) * nanosInMillis), // Convert milliseconds to nanoseconds.
-		EndTime:     time.Duration(int64(endTime) * nanosInMillis),
-		StartOffset: startOffset,
-		EndOffset:   endOffset,
-		Title:       &title,
-		Description: &description,
-		Link:        &link,
-		Artwork:     &artwork,
+	switch f := frame.(type) {
+	case TextFrame:
+		if id == TitleFrameID {
+			cf.Title = &f
+		} else {
+			cf.Description = &f
+		}
+	case LinkFrame:
+		cf.Link = &f
+	case PictureFrame:
+		cf.Artwork = &f
 	}
 
-	return cf, nil
+	return nil
 }
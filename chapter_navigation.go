@@ -0,0 +1,42 @@
+package id3v2
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// Chapters returns the chapter frames (CHAP) of the tag ordered by their start time.
+// Chapters with the same start time keep the order in which they were added.
+func (tag *Tag) Chapters() []ChapterFrame {
+	frames := tag.GetFrames(tag.CommonID("Chapters"))
+	chapters := make([]ChapterFrame, 0, len(frames))
+
+	for _, f := range frames {
+		if cf, ok := f.(ChapterFrame); ok {
+			chapters = append(chapters, cf)
+		}
+	}
+
+	slices.SortStableFunc(chapters, func(a, b ChapterFrame) int {
+		return cmp.Compare(a.StartTime, b.StartTime)
+	})
+
+	return chapters
+}
+
+// ChapterAt returns the chapter playing at d and its index in Chapters,
+// i.e. the chapter with the latest start time that isn't after d and whose end time is after d,
+// so the innermost one is returned for nested chapters.
+// It returns -1 if no chapter covers d.
+func (tag *Tag) ChapterAt(d time.Duration) (ChapterFrame, int) {
+	chapters := tag.Chapters()
+
+	for i := len(chapters) - 1; i >= 0; i-- {
+		if chapters[i].StartTime <= d && d < chapters[i].EndTime {
+			return chapters[i], i
+		}
+	}
+
+	return ChapterFrame{}, -1
+}
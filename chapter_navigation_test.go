@@ -0,0 +1,46 @@
+package id3v2
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestChapterNavigation(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.AddChapterFrame(ChapterFrame{ElementID: "chp2", StartTime: 10 * time.Second, EndTime: 20 * time.Second})
+	tag.AddChapterFrame(ChapterFrame{ElementID: "chp1", StartTime: 0, EndTime: 10 * time.Second})
+	tag.AddChapterFrame(ChapterFrame{ElementID: "chp2a", StartTime: 15 * time.Second, EndTime: 18 * time.Second})
+
+	chapters := tag.Chapters()
+
+	var ids []string
+	for _, cf := range chapters {
+		ids = append(ids, cf.ElementID)
+	}
+
+	if expected := "[chp1 chp2 chp2a]"; fmt.Sprint(ids) != expected {
+		t.Errorf("Expected %v, got %v", expected, ids)
+	}
+
+	testCases := []struct {
+		at    time.Duration
+		id    string
+		index int
+	}{
+		{0, "chp1", 0},
+		{10 * time.Second, "chp2", 1},
+		{16 * time.Second, "chp2a", 2},
+		{18 * time.Second, "chp2", 1},
+		{20 * time.Second, "", -1},
+	}
+
+	for _, tc := range testCases {
+		cf, i := tag.ChapterAt(tc.at)
+		if cf.ElementID != tc.id || i != tc.index {
+			t.Errorf("At %v: expected %q (%d), got %q (%d)", tc.at, tc.id, tc.index, cf.ElementID, i)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package id3v2
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrChapterOffsetMismatch is returned when the byte offsets of a chapter don't match its times.
+var ErrChapterOffsetMismatch = errors.New("chapter offsets don't match chapter times")
+
+// ByteOffset returns the offset in the file of the audio frame playing at d.
+// Times beyond the duration of the stream are mapped to its end.
+// The offset is exact for streams with a constant bitrate and estimated from the average bitrate otherwise.
+// It returns the offset of the first audio frame if the stream is unknown.
+func (info AudioInfo) ByteOffset(d time.Duration) int64 {
+	if info.SampleRate <= 0 || d <= 0 {
+		return info.Offset
+	}
+
+	samples := float64(mpegFrameHeader{version: info.Version, layer: info.Layer}.samplesPerFrame())
+	frame := math.Floor(min(d, info.Duration).Seconds() * float64(info.SampleRate) / samples)
+
+	return info.Offset + int64(frame*info.averageFrameLength())
+}
+
+// averageFrameLength returns the average length of an audio frame in bytes.
+func (info AudioInfo) averageFrameLength() float64 {
+	if info.SampleRate <= 0 {
+		return 0
+	}
+
+	samples := float64(mpegFrameHeader{version: info.Version, layer: info.Layer}.samplesPerFrame())
+
+	return samples * float64(info.Bitrate) * 1000 / 8 / float64(info.SampleRate)
+}
+
+// WithByteOffsets returns a copy of the chapter with StartOffset and EndOffset computed from StartTime and EndTime,
+// as some players seek by byte offsets rather than by times. See AudioInfo.ByteOffset for the accuracy.
+// The offsets count from the beginning of the file, so they should be computed
+// once the size of the tag is final, e.g. with the audio info read after Save.
+// It returns ErrSizeOverflow if an offset doesn't fit in 32 bits.
+func (cf ChapterFrame) WithByteOffsets(info AudioInfo) (ChapterFrame, error) {
+	start, end := info.ByteOffset(cf.StartTime), info.ByteOffset(cf.EndTime)
+	if end >= IgnoredOffset {
+		return cf, fmt.Errorf("%w: chapter %q ends at byte %d", ErrSizeOverflow, cf.ElementID, end)
+	}
+
+	cf.StartOffset, cf.EndOffset = uint32(start), uint32(end)
+
+	return cf, nil
+}
+
+// CheckByteOffsets returns ErrChapterOffsetMismatch if StartOffset or EndOffset is more than one audio frame
+// away from the offset computed from StartTime or EndTime. Offsets set to IgnoredOffset aren't checked.
+func (cf ChapterFrame) CheckByteOffsets(info AudioInfo) error {
+	tolerance := int64(math.Ceil(info.averageFrameLength()))
+
+	check := func(name string, offset uint32, d time.Duration) error {
+		if offset == IgnoredOffset {
+			return nil
+		}
+
+		expected := info.ByteOffset(d)
+		if diff := int64(offset) - expected; diff > tolerance || -diff > tolerance {
+			return fmt.Errorf("%w: chapter %q %s offset is %d, expected %d",
+				ErrChapterOffsetMismatch, cf.ElementID, name, offset, expected)
+		}
+
+		return nil
+	}
+
+	if err := check("start", cf.StartOffset, cf.StartTime); err != nil {
+		return err
+	}
+
+	return check("end", cf.EndOffset, cf.EndTime)
+}
+
+// SetChapterByteOffsets computes StartOffset and EndOffset of all chapters from their times,
+// see ChapterFrame.WithByteOffsets. The chapters are left unchanged if any offset doesn't fit in 32 bits.
+// It returns ErrReadOnlyTag if the tag is read-only.
+func (tag *Tag) SetChapterByteOffsets(info AudioInfo) error {
+	if tag.rejectChange() {
+		return ErrReadOnlyTag
+	}
+
+	frames := tag.GetFrames(tag.CommonID("Chapters"))
+	chapters := make([]ChapterFrame, 0, len(frames))
+
+	for _, f := range frames {
+		cf, ok := f.(ChapterFrame)
+		if !ok {
+			continue
+		}
+
+		cf, err := cf.WithByteOffsets(info)
+		if err != nil {
+			return err
+		}
+
+		chapters = append(chapters, cf)
+	}
+
+	for _, cf := range chapters {
+		tag.AddChapterFrame(cf)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChapterByteOffsets(t *testing.T) {
+	t.Parallel()
+
+	data := append([]byte{0, 0, 0}, makeMPEGFrames(100)...)
+
+	info, err := ReadAudioInfo(bytes.NewReader(data), 0, int64(len(data)))
+	if err != nil {
+		t.Fatal("Error while reading audio info:", err)
+	}
+
+	tag := NewEmptyTag()
+	tag.AddChapterFrame(ChapterFrame{ElementID: "chp1", EndTime: time.Second})
+	tag.AddChapterFrame(ChapterFrame{ElementID: "chp2", StartTime: time.Second, EndTime: time.Hour})
+
+	if err = tag.SetChapterByteOffsets(info); err != nil {
+		t.Fatal(err)
+	}
+
+	// 38 frames of 417.96 bytes on average are played before the first second.
+	expected := map[string][2]uint32{"chp1": {3, 3 + 15882}, "chp2": {3 + 15882, uint32(info.ByteOffset(info.Duration))}}
+
+	for _, f := range tag.GetFrames(tag.CommonID("Chapters")) {
+		cf, _ := f.(ChapterFrame)
+		if offsets := [2]uint32{cf.StartOffset, cf.EndOffset}; offsets != expected[cf.ElementID] {
+			t.Errorf("Expected offsets %v of %s, got %v", expected[cf.ElementID], cf.ElementID, offsets)
+		}
+
+		if err = cf.CheckByteOffsets(info); err != nil {
+			t.Errorf("Expected offsets of %s to match, got %v", cf.ElementID, err)
+		}
+	}
+
+	chapter := ChapterFrame{ElementID: "chp3", EndTime: time.Second, StartOffset: IgnoredOffset, EndOffset: 3}
+	if err = chapter.CheckByteOffsets(info); !errors.Is(err, ErrChapterOffsetMismatch) {
+		t.Errorf("Expected %v, got %v", ErrChapterOffsetMismatch, err)
+	}
+}
@@ -0,0 +1,56 @@
+package id3v2
+
+import "sync"
+
+var (
+	// commonIDAliases holds the aliases registered with RegisterCommonIDAlias.
+	commonIDAliases = map[string]string{}
+
+	// commonIDAliasesMu guards commonIDAliases.
+	commonIDAliasesMu sync.RWMutex
+)
+
+// RegisterCommonIDAlias registers a custom description for the frame ID for all tags,
+// so tag.CommonID and the APIs built on it (e.g., Options.ParseFrames) accept domain vocabulary,
+// e.g. RegisterCommonIDAlias("Label", FrameTPUB).
+// ID3v2.3 and ID3v2.4 frame IDs are converted to the tag's version like in tag.CommonID.
+// Aliases take precedence over V23CommonIDs and V24CommonIDs. It's safe for concurrent use.
+func RegisterCommonIDAlias(description, id string) {
+	commonIDAliasesMu.Lock()
+	defer commonIDAliasesMu.Unlock()
+
+	commonIDAliases[description] = id
+}
+
+// UnregisterCommonIDAlias removes the alias registered with RegisterCommonIDAlias.
+func UnregisterCommonIDAlias(description string) {
+	commonIDAliasesMu.Lock()
+	defer commonIDAliasesMu.Unlock()
+
+	delete(commonIDAliases, description)
+}
+
+// SetCommonIDAlias registers a custom description for the frame ID for this tag only,
+// overriding aliases registered with RegisterCommonIDAlias.
+// To use the alias in Options.ParseFrames, set it before parsing with tag.Reset.
+func (tag *Tag) SetCommonIDAlias(description, id string) {
+	if tag.commonIDAliases == nil {
+		tag.commonIDAliases = make(map[string]string)
+	}
+
+	tag.commonIDAliases[description] = id
+}
+
+// commonIDAlias returns the frame ID the description is an alias of.
+func (tag *Tag) commonIDAlias(description string) (string, bool) {
+	if id, ok := tag.commonIDAliases[description]; ok {
+		return id, true
+	}
+
+	commonIDAliasesMu.RLock()
+	defer commonIDAliasesMu.RUnlock()
+
+	id, ok := commonIDAliases[description]
+
+	return id, ok
+}
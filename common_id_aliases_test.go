@@ -0,0 +1,60 @@
+package id3v2
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCommonIDAliases(t *testing.T) {
+	t.Parallel()
+
+	RegisterCommonIDAlias("Test label", FrameTPUB)
+	RegisterCommonIDAlias("Test released", FrameTYER)
+
+	defer UnregisterCommonIDAlias("Test label")
+	defer UnregisterCommonIDAlias("Test released")
+
+	tag := NewEmptyTag()
+
+	if id := tag.CommonID("Test label"); id != FrameTPUB {
+		t.Errorf("Expected %v, got %v", FrameTPUB, id)
+	}
+
+	if id := tag.CommonID("Test released"); id != FrameTDRC {
+		t.Errorf("Expected %v, got %v", FrameTDRC, id)
+	}
+
+	tag.SetCommonIDAlias("Test label", FrameTCOP)
+
+	if id := tag.CommonID("Test label"); id != FrameTCOP {
+		t.Errorf("Expected %v, got %v", FrameTCOP, id)
+	}
+
+	if id := NewEmptyTag().CommonID("Test label"); id != FrameTPUB {
+		t.Errorf("Expected %v, got %v", FrameTPUB, id)
+	}
+}
+
+func TestCommonIDAliasParseFrames(t *testing.T) {
+	t.Parallel()
+
+	source := NewEmptyTag()
+	source.SetTitle("Title")
+	source.AddTextFrame(FrameTPUB, EncodingUTF8, "Label")
+
+	data, err := source.Bytes()
+	if err != nil {
+		t.Fatalf("Error serializing tag: %v", err)
+	}
+
+	tag := NewEmptyTag()
+	tag.SetCommonIDAlias("Label", FrameTPUB)
+
+	if err = tag.Reset(bytes.NewReader(data), Options{Parse: true, ParseFrames: []string{"Label"}}); err != nil {
+		t.Fatalf("Error parsing tag: %v", err)
+	}
+
+	if tag.Count() != 1 || tag.GetTextFrame(tag.CommonID("Label")).Text != "Label" {
+		t.Errorf("Expected only the label frame to be parsed, got %v", tag.AllFrames())
+	}
+}
@@ -108,6 +108,7 @@ var (
 		"Software/Hardware and settings used for encoding": "TSSE",
 		"Subtitle/Description refinement":                  SubtitleRefinementFrameID,
 		"Synchronised lyrics/text":                         "SYLT",
+		"Table of contents":                                "CTOC",
 		"Time":                                             "TIME",
 		"Title/Songname/Content description":               TitleFrameID,
 		"Track number/Position in set":                     "TRCK",
@@ -171,6 +172,7 @@ var (
 		"Software/Hardware and settings used for encoding": "TSSE",
 		"Subtitle/Description refinement":                  SubtitleRefinementFrameID,
 		"Synchronised lyrics/text":                         "SYLT",
+		"Table of contents":                                "CTOC",
 		"Tagging time":                                     "TDTG",
 		"Title sort order":                                 "TSOT",
 		"Title/Songname/Content description":               TitleFrameID,
@@ -206,6 +208,7 @@ var parsers = map[string]func(*bufferedReader, byte) (Framer, error){
 	"APIC":                 parsePictureFrame,              // Parser for picture frames.
 	"CHAP":                 parseChapterFrame,              // Parser for chapter frames.
 	"COMM":                 parseCommentFrame,              // Parser for comment frames.
+	"CTOC":                 parseTableOfContentsFrame,      // Parser for table of contents frames.
 	"POPM":                 parsePopularimeterFrame,        // Parser for popularimeter frames.
 	"SYLT":                 parseSynchronisedLyricsFrame,   // Parser for synchronized lyrics frames.
 	UserDefinedTextFrameID: parseUserDefinedTextFrame,      // Parser for user-defined text frames.
@@ -0,0 +1,76 @@
+package id3v2
+
+// compatibilityFramePairs maps the ID3v2.4 frames to their ID3v2.3 equivalents
+// that are copied as is with WriteOptions.CompatibilityFrames.
+var compatibilityFramePairs = map[string]string{
+	"TIPL": "IPLS",
+}
+
+// addCompatibilityFrames adds the frames of the other ID3v2 version next to the ones of the tag's version:
+// the date in TDRC and in TYER, TDAT and TIME, and the involved people in TIPL and IPLS.
+// Frames that are already present are kept.
+func (tag *Tag) addCompatibilityFrames() {
+	other := byte(3)
+	if tag.Version() == 3 {
+		other = 4
+	}
+
+	if release, err := tag.ReleaseDate(); err == nil && !release.IsZero() {
+		current := tag.version
+
+		tag.version = other
+		if existing, _ := tag.ReleaseDate(); existing.IsZero() {
+			tag.SetReleaseDate(release)
+		}
+
+		tag.version = current
+	}
+
+	for v24ID, v23ID := range compatibilityFramePairs {
+		from, to := v24ID, v23ID
+		if tag.Version() == 3 {
+			from, to = v23ID, v24ID
+		}
+
+		if f := tag.GetLastFrame(from); f != nil && tag.GetLastFrame(to) == nil {
+			tag.AddFrame(to, f)
+		}
+	}
+}
+
+// RemoveCompatibilityFrames removes the frames of the other ID3v2 version duplicating the ones
+// of the tag's version, e.g. left by writing with WriteOptions.CompatibilityFrames:
+// TYER, TDAT and TIME next to TDRC and IPLS next to TIPL in an ID3v2.4 tag, and vice versa.
+// Frames without a counterpart of the tag's version are kept. It returns the number of removed frames.
+func (tag *Tag) RemoveCompatibilityFrames() int {
+	if tag.rejectChange() {
+		return 0
+	}
+
+	var removed int
+
+	remove := func(ids ...string) {
+		for _, id := range ids {
+			removed += len(tag.GetFrames(id))
+			tag.DeleteFrames(id)
+		}
+	}
+
+	if tag.Version() == 4 {
+		if tag.GetLastFrame("TDRC") != nil {
+			remove("TYER", "TDAT", "TIME")
+		}
+	} else if tag.GetLastFrame("TYER") != nil {
+		remove("TDRC")
+	}
+
+	for v24ID, v23ID := range compatibilityFramePairs {
+		if tag.Version() == 4 && tag.GetLastFrame(v24ID) != nil {
+			remove(v23ID)
+		} else if tag.Version() == 3 && tag.GetLastFrame(v23ID) != nil {
+			remove(v24ID)
+		}
+	}
+
+	return removed
+}
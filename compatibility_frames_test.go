@@ -0,0 +1,63 @@
+package id3v2
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCompatibilityFrames(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetVersion(4)
+	tag.SetReleaseDate(Timestamp{Time: time.Date(2024, time.May, 17, 20, 15, 0, 0, time.UTC), Precision: PrecisionMinute})
+	tag.AddTextFrame("TIPL", EncodingUTF8, "producer")
+	tag.SetWriteOptions(WriteOptions{CompatibilityFrames: true})
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatalf("Error while writing tag: %v", err)
+	}
+
+	if tag.GetLastFrame("TYER") != nil {
+		t.Error("Expected the frames stored in the tag to be unchanged")
+	}
+
+	parsed, err := ParseBytes(data, Options{Parse: true})
+	if err != nil {
+		t.Fatalf("Error while parsing tag: %v", err)
+	}
+
+	expected := map[string]string{
+		"TDRC": "2024-05-17T20:15",
+		"TYER": "2024",
+		"TDAT": "1705",
+		"TIME": "2015",
+		"TIPL": "producer",
+	}
+
+	for id, text := range expected {
+		if actual := parsed.GetTextFrame(id).Text; actual != text {
+			t.Errorf("Expected %s %q, got %q", id, text, actual)
+		}
+	}
+
+	// IPLS isn't a text frame by its ID, so it's parsed as an unknown frame.
+	if ipls, ok := parsed.GetLastFrame("IPLS").(UnknownFrame); !ok || string(ipls.Body) != "\x03producer\x00" {
+		t.Errorf("Expected IPLS frame with the TIPL body, got %v", parsed.GetLastFrame("IPLS"))
+	}
+
+	if removed := parsed.RemoveCompatibilityFrames(); removed != 4 {
+		t.Errorf("Expected 4 removed frames, got %d", removed)
+	}
+
+	for _, id := range []string{"TYER", "TDAT", "TIME", "IPLS"} {
+		if parsed.GetLastFrame(id) != nil {
+			t.Errorf("Expected frame %s to be removed", id)
+		}
+	}
+
+	if parsed.GetTextFrame("TDRC").Text != expected["TDRC"] || parsed.GetTextFrame("TIPL").Text != expected["TIPL"] {
+		t.Errorf("Expected ID3v2.4 frames to be kept, got frames %v", parsed.AllFrames())
+	}
+}
@@ -0,0 +1,288 @@
+package id3v2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+)
+
+// Container represents the type of file the tag is stored in.
+type Container byte
+
+// Available containers.
+const (
+	// ContainerMP3 is a plain audio file with the tag at the beginning, e.g. MP3.
+	ContainerMP3 Container = iota
+
+	// ContainerDSF is a DSD Stream File. The tag is located at the end of the file
+	// at the offset recorded in the "DSD " chunk.
+	ContainerDSF
+
+	// ContainerDFF is a DSDIFF file. The tag is stored in an "ID3 " chunk.
+	ContainerDFF
+)
+
+// String returns the name of the container, e.g. "DSF".
+func (c Container) String() string {
+	switch c {
+	case ContainerDSF:
+		return "DSF"
+	case ContainerDFF:
+		return "DFF"
+	default:
+		return "MP3"
+	}
+}
+
+const (
+	// dsfHeaderSize is the size of the "DSD " chunk at the beginning of DSF files.
+	dsfHeaderSize = 28
+
+	// dsfFileSizeOffset is the offset of the total file size in the "DSD " chunk.
+	dsfFileSizeOffset = 12
+
+	// dsfMetadataPointerOffset is the offset of the pointer to the tag in the "DSD " chunk.
+	dsfMetadataPointerOffset = 20
+
+	// dffHeaderSize is the size of the "FRM8" chunk header including the "DSD " form type.
+	dffHeaderSize = 16
+
+	// dffChunkHeaderSize is the size of a DSDIFF chunk header: a 4-byte ID and an 8-byte size.
+	dffChunkHeaderSize = 12
+
+	// dffID3ChunkID is the ID of the DSDIFF chunk holding the ID3v2 tag.
+	dffID3ChunkID = "ID3 "
+)
+
+// ErrInvalidContainer is returned when a DSF or DFF file has a malformed structure.
+var ErrInvalidContainer = errors.New("invalid container structure")
+
+// Container returns the type of file the tag was read from.
+func (tag *Tag) Container() Container {
+	return tag.container
+}
+
+// detectContainer checks whether the reader is a DSF or DFF file.
+// For these containers, it returns a reader positioned at the tag and the offset of the tag.
+// If the container has no tag, the returned reader is empty.
+// Readers that don't support io.ReaderAt are always treated as ContainerMP3.
+func detectContainer(rd io.Reader) (Container, io.Reader, int64, error) {
+	ra, ok := rd.(io.ReaderAt)
+	if !ok {
+		return ContainerMP3, rd, 0, nil
+	}
+
+	magic := make([]byte, 4)
+	if _, err := ra.ReadAt(magic, 0); err != nil {
+		// Too small files can't be containers, let the header parser handle them.
+		return ContainerMP3, rd, 0, nil //nolint:nilerr // The error is reported by the header parser.
+	}
+
+	switch string(magic) {
+	case "DSD ":
+		src, offset, err := findDSFTag(ra)
+
+		return ContainerDSF, src, offset, err
+	case "FRM8":
+		src, offset, err := findDFFTag(ra)
+
+		return ContainerDFF, src, offset, err
+	default:
+		return ContainerMP3, rd, 0, nil
+	}
+}
+
+// findDSFTag returns a reader positioned at the tag of a DSF file and the offset of the tag.
+func findDSFTag(ra io.ReaderAt) (io.Reader, int64, error) {
+	header := make([]byte, dsfHeaderSize)
+	if _, err := ra.ReadAt(header, 0); err != nil {
+		return nil, 0, ErrInvalidContainer
+	}
+
+	fileSize := int64(binary.LittleEndian.Uint64(header[dsfFileSizeOffset:]))
+	pointer := int64(binary.LittleEndian.Uint64(header[dsfMetadataPointerOffset:]))
+
+	// A zero pointer means that the file has no tag.
+	if pointer == 0 {
+		return bytes.NewReader(nil), 0, nil
+	}
+
+	if pointer < dsfHeaderSize || pointer > fileSize {
+		return nil, 0, ErrInvalidContainer
+	}
+
+	return io.NewSectionReader(ra, pointer, fileSize-pointer), pointer, nil
+}
+
+// findDFFTag returns a reader positioned at the tag stored in the "ID3 " chunk of a DFF file
+// and the offset of the tag.
+func findDFFTag(ra io.ReaderAt) (io.Reader, int64, error) {
+	header := make([]byte, dffHeaderSize)
+	if _, err := ra.ReadAt(header, 0); err != nil || string(header[12:16]) != "DSD " {
+		return nil, 0, ErrInvalidContainer
+	}
+
+	end := dffChunkHeaderSize + int64(binary.BigEndian.Uint64(header[4:12]))
+
+	chunkHeader := make([]byte, dffChunkHeaderSize)
+
+	// Iterate over the top-level chunks looking for the "ID3 " chunk.
+	for offset := int64(dffHeaderSize); offset+dffChunkHeaderSize <= end; {
+		if _, err := ra.ReadAt(chunkHeader, offset); err != nil {
+			return nil, 0, ErrInvalidContainer
+		}
+
+		chunkSize := int64(binary.BigEndian.Uint64(chunkHeader[4:]))
+		dataOffset := offset + dffChunkHeaderSize
+
+		if string(chunkHeader[:4]) == dffID3ChunkID {
+			return io.NewSectionReader(ra, dataOffset, chunkSize), dataOffset, nil
+		}
+
+		// Chunks are padded to an even size.
+		offset = dataOffset + chunkSize + chunkSize%2
+	}
+
+	return bytes.NewReader(nil), 0, nil
+}
+
+// writeDSFFile writes the original DSF file of size `size` with the new tag at its end to w.
+// It returns the number of bytes written for the tag and the offset of the tag.
+func (tag *Tag) writeDSFFile(w *os.File, originalFile *os.File, size int64) (int64, int64, error) {
+	// The tag is always located at the end of the file, everything before it is kept.
+	tagOffset := size
+	if tag.originalSize > 0 {
+		tagOffset = tag.offset
+	}
+
+	if err := copySection(w, originalFile, 0, tagOffset); err != nil {
+		return 0, 0, err
+	}
+
+	tagSize, err := tag.WriteTo(w)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Update the total file size and the pointer to the tag in the "DSD " chunk.
+	pointer := tagOffset
+	if tagSize == 0 {
+		pointer = 0
+	}
+
+	header := make([]byte, 16)
+	binary.LittleEndian.PutUint64(header, uint64(tagOffset+tagSize))
+	binary.LittleEndian.PutUint64(header[8:], uint64(pointer))
+
+	if _, err = w.WriteAt(header, dsfFileSizeOffset); err != nil {
+		return 0, 0, err
+	}
+
+	return tagSize, pointer, nil
+}
+
+// writeDFFFile writes the original DFF file of size `size` with the new tag in an "ID3 " chunk to w.
+// The existing "ID3 " chunk is removed and the new one is appended at the end of the file.
+// It returns the number of bytes written for the tag and the offset of the tag.
+func (tag *Tag) writeDFFFile(w *os.File, originalFile *os.File, size int64) (int64, int64, error) {
+	if tag.originalSize == 0 {
+		if err := copySection(w, originalFile, 0, size); err != nil {
+			return 0, 0, err
+		}
+	} else {
+		// Read the size of the existing chunk to skip it together with its padding.
+		chunkHeader := make([]byte, dffChunkHeaderSize)
+		if _, err := originalFile.ReadAt(chunkHeader, tag.offset-dffChunkHeaderSize); err != nil {
+			return 0, 0, err
+		}
+
+		chunkSize := int64(binary.BigEndian.Uint64(chunkHeader[4:]))
+		chunkEnd := tag.offset + chunkSize + chunkSize%2
+
+		if err := copySection(w, originalFile, 0, tag.offset-dffChunkHeaderSize); err != nil {
+			return 0, 0, err
+		}
+
+		if err := copySection(w, originalFile, chunkEnd, max(size-chunkEnd, 0)); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	end, err := w.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var tagSize, tagOffset int64
+
+	if tag.HasFrames() {
+		// Write the chunk header, the tag and the pad byte if needed.
+		// The size in the chunk header is filled in after the tag is written,
+		// as serializers and write options may change the size of the written tag.
+		chunkHeader := make([]byte, dffChunkHeaderSize)
+		copy(chunkHeader, dffID3ChunkID)
+
+		if _, err = w.Write(chunkHeader); err != nil {
+			return 0, 0, err
+		}
+
+		if tagSize, err = tag.WriteTo(w); err != nil {
+			return 0, 0, err
+		}
+
+		binary.BigEndian.PutUint64(chunkHeader[4:], uint64(tagSize))
+
+		if _, err = w.WriteAt(chunkHeader[4:], end+4); err != nil {
+			return 0, 0, err
+		}
+
+		if tagSize%2 != 0 {
+			if _, err = w.Write([]byte{0}); err != nil {
+				return 0, 0, err
+			}
+		}
+
+		tagOffset = end + dffChunkHeaderSize
+		end = tagOffset + tagSize + tagSize%2
+	}
+
+	// Update the size of the "FRM8" chunk.
+	frameSize := make([]byte, 8)
+	binary.BigEndian.PutUint64(frameSize, uint64(end-dffChunkHeaderSize))
+
+	if _, err = w.WriteAt(frameSize, 4); err != nil {
+		return 0, 0, err
+	}
+
+	return tagSize, tagOffset, nil
+}
+
+// containerOverhead returns the difference in the number of bytes the container adds around the tag
+// of the given size between the new and the original file.
+func (tag *Tag) containerOverhead(size int) int64 {
+	if tag.container != ContainerDFF || !tag.HasFrames() {
+		return 0
+	}
+
+	// Chunks are padded to an even size.
+	overhead := int64(size%2) - tag.originalSize%2
+
+	// A new "ID3 " chunk needs a header.
+	if tag.originalSize == 0 {
+		overhead += dffChunkHeaderSize
+	}
+
+	return overhead
+}
+
+// copySection copies `n` bytes starting at `offset` of `r` to w.
+func copySection(w io.Writer, r io.ReaderAt, offset, n int64) error {
+	buf := getByteSlice(defaultSaveBufferSize)
+	defer putByteSlice(buf)
+
+	_, err := io.CopyBuffer(w, io.NewSectionReader(r, offset, n), buf)
+
+	return err
+}
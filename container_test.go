@@ -0,0 +1,148 @@
+package id3v2
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// makeDSFFile builds a minimal DSF file without a tag.
+func makeDSFFile() []byte {
+	header := make([]byte, dsfHeaderSize)
+	copy(header, "DSD ")
+	binary.LittleEndian.PutUint64(header[4:], dsfHeaderSize)
+
+	fmtChunk := make([]byte, 52)
+	copy(fmtChunk, "fmt ")
+	binary.LittleEndian.PutUint64(fmtChunk[4:], uint64(len(fmtChunk)))
+
+	dataChunk := make([]byte, 12+100)
+	copy(dataChunk, "data")
+	binary.LittleEndian.PutUint64(dataChunk[4:], uint64(len(dataChunk)))
+
+	file := concat(header, fmtChunk, dataChunk)
+	binary.LittleEndian.PutUint64(file[dsfFileSizeOffset:], uint64(len(file)))
+
+	return file
+}
+
+// makeDFFFile builds a minimal DFF file without a tag.
+func makeDFFFile() []byte {
+	chunk := func(id string, size int) []byte {
+		b := make([]byte, dffChunkHeaderSize+size+size%2)
+		copy(b, id)
+		binary.BigEndian.PutUint64(b[4:], uint64(size))
+
+		return b
+	}
+
+	file := concat([]byte("FRM8"), make([]byte, 8), []byte("DSD "), chunk("FVER", 4), chunk("DSD ", 101))
+	binary.BigEndian.PutUint64(file[4:], uint64(len(file)-dffChunkHeaderSize))
+
+	return file
+}
+
+func TestContainers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		container Container
+		data      []byte
+	}{
+		{"DSF", ContainerDSF, makeDSFFile()},
+		{"DFF", ContainerDFF, makeDFFFile()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			name := filepath.Join(t.TempDir(), "test."+tt.name)
+			if err := os.WriteFile(name, tt.data, 0o600); err != nil {
+				t.Fatal("Error while writing file:", err)
+			}
+
+			for _, title := range []string{"First title", "Second, longer title"} {
+				tag, err := Open(name, parseOpts)
+				if err != nil {
+					t.Fatal("Error while opening file:", err)
+				}
+
+				if tag.Container() != tt.container {
+					t.Errorf("Expected container %v, got %v", tt.container, tag.Container())
+				}
+
+				tag.SetTitle(title)
+
+				estimated, err := tag.EstimateFileSize()
+				if err != nil {
+					t.Fatal("Error while estimating file size:", err)
+				}
+
+				if err = tag.Save(); err != nil {
+					t.Fatal("Error while saving tag:", err)
+				}
+
+				tag.Close()
+
+				assertContainerFile(t, name, tt.container, estimated)
+
+				parsed, err := Open(name, parseOpts)
+				if err != nil {
+					t.Fatal("Error while opening file:", err)
+				}
+
+				if parsed.Title() != title {
+					t.Errorf("Expected title %q, got %q", title, parsed.Title())
+				}
+
+				parsed.Close()
+			}
+
+			if err := RemoveTag(name); err != nil {
+				t.Fatal("Error while removing tag:", err)
+			}
+
+			data, err := os.ReadFile(name)
+			if err != nil {
+				t.Fatal("Error while reading file:", err)
+			}
+
+			if string(data) != string(tt.data) {
+				t.Error("Expected the original file after removing the tag")
+			}
+		})
+	}
+}
+
+// assertContainerFile checks that the container header describes the file correctly.
+func assertContainerFile(t *testing.T, name string, container Container, expectedSize int64) {
+	t.Helper()
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal("Error while reading file:", err)
+	}
+
+	if int64(len(data)) != expectedSize {
+		t.Errorf("Expected file size %v, got %v", expectedSize, len(data))
+	}
+
+	switch container {
+	case ContainerDSF:
+		if size := binary.LittleEndian.Uint64(data[dsfFileSizeOffset:]); size != uint64(len(data)) {
+			t.Errorf("Expected DSF file size %v, got %v", len(data), size)
+		}
+
+		pointer := binary.LittleEndian.Uint64(data[dsfMetadataPointerOffset:])
+		if !isID3Tag(data[pointer : pointer+3]) {
+			t.Errorf("Expected tag at offset %v", pointer)
+		}
+	case ContainerDFF:
+		if size := binary.BigEndian.Uint64(data[4:]); size != uint64(len(data)-dffChunkHeaderSize) {
+			t.Errorf("Expected FRM8 size %v, got %v", len(data)-dffChunkHeaderSize, size)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package id3v2
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+)
+
+// defaultCSVPathColumn is the default name of the CSV column holding file paths.
+const defaultCSVPathColumn = "path"
+
+// ErrNoPathColumn is returned when the CSV header doesn't contain the path column.
+var ErrNoPathColumn = errors.New("CSV header has no path column")
+
+// CSVOptions defines the settings that influence how ApplyCSV retags files.
+type CSVOptions struct {
+	// PathColumn is the name of the column holding file paths. If empty, "path" is used.
+	PathColumn string
+
+	// Columns maps CSV column names to the keys used by ApplyMap (e.g., "Artist", "TXXX:Foo", "COMM:").
+	// If Columns is nil, every column except the path column is used as a key as is.
+	// Columns that aren't listed are ignored.
+	Columns map[string]string
+
+	// Workers is the number of files processed concurrently. If it's less than 1, the number of CPUs is used.
+	Workers int
+
+	// Save defines how the files are saved.
+	Save SaveOptions
+}
+
+// CSVRowError describes a failure to retag the file of a single CSV row.
+type CSVRowError struct {
+	Row  int    // The number of the row in the CSV file, the header is row 1.
+	Path string // The path of the file.
+	Err  error  // The error that occurred.
+}
+
+// Error returns the error message including the row number and the path.
+func (e CSVRowError) Error() string {
+	return fmt.Sprintf("row %d (%s): %v", e.Row, e.Path, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e CSVRowError) Unwrap() error {
+	return e.Err
+}
+
+// CSVReport holds the result of ApplyCSV.
+type CSVReport struct {
+	Applied int           // The number of files retagged successfully.
+	Errors  []CSVRowError // The rows that failed, ordered by row number.
+}
+
+// ApplyCSV reads a CSV file with a header row from r and retags the file of every row concurrently.
+// Every row holds a file path and the values applied to its tag with ApplyMap.
+// Empty cells are skipped, so they don't delete existing frames.
+// Failures of individual rows don't stop processing and are reported in CSVReport.Errors.
+// The returned error is non-nil only if the CSV itself can't be read.
+func ApplyCSV(r io.Reader, opts CSVOptions) (CSVReport, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return CSVReport{}, fmt.Errorf("error by reading CSV header: %w", err)
+	}
+
+	pathColumn := opts.PathColumn
+	if pathColumn == "" {
+		pathColumn = defaultCSVPathColumn
+	}
+
+	pathIndex := slices.Index(header, pathColumn)
+	if pathIndex < 0 {
+		return CSVReport{}, fmt.Errorf("%w: %q", ErrNoPathColumn, pathColumn)
+	}
+
+	// Read all rows first, so a malformed CSV doesn't leave the files half-processed.
+	records, err := reader.ReadAll()
+	if err != nil {
+		return CSVReport{}, fmt.Errorf("error by reading CSV: %w", err)
+	}
+
+	var (
+		report CSVReport
+		mu     sync.Mutex
+	)
+
+	rows := func(yield func(int) bool) {
+		for i := range records {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	processConcurrently(opts.Workers, rows, func(i int) {
+		record := records[i]
+
+		var path string
+		if pathIndex < len(record) {
+			path = record[pathIndex]
+		}
+
+		err := applyCSVRecord(path, csvRecordToMap(header, record, pathIndex, opts.Columns), opts.Save)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if err != nil {
+			// The header is row 1, so the first record is row 2.
+			report.Errors = append(report.Errors, CSVRowError{Row: i + 2, Path: path, Err: err})
+		} else {
+			report.Applied++
+		}
+	})
+
+	slices.SortFunc(report.Errors, func(a, b CSVRowError) int {
+		return a.Row - b.Row
+	})
+
+	return report, nil
+}
+
+// csvRecordToMap converts a CSV record to a map for ApplyMap, skipping the path column and empty cells.
+func csvRecordToMap(header, record []string, pathIndex int, columns map[string]string) map[string][]string {
+	m := make(map[string][]string, len(record))
+
+	for i, value := range record {
+		if i == pathIndex || i >= len(header) || value == "" {
+			continue
+		}
+
+		key := header[i]
+
+		if columns != nil {
+			mapped, ok := columns[key]
+			if !ok {
+				continue
+			}
+
+			key = mapped
+		}
+
+		m[key] = append(m[key], value)
+	}
+
+	return m
+}
+
+// applyCSVRecord opens the file, applies the values and saves it.
+func applyCSVRecord(path string, values map[string][]string, opts SaveOptions) error {
+	if path == "" {
+		return ErrNoFile
+	}
+
+	tag, err := Open(path, Options{Parse: true})
+	if err != nil {
+		return err
+	}
+	defer tag.Close()
+
+	tag.ApplyMap(values)
+
+	return tag.SaveWithOptions(opts)
+}
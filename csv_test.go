@@ -0,0 +1,83 @@
+package id3v2
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestApplyCSV(t *testing.T) {
+	first, err := prepareTestFile("csv_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(first.Name())
+
+	first.Close()
+
+	second, err := prepareTestFile("csv_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(second.Name())
+
+	second.Close()
+
+	csvData := "file,artist,title,ignored\n" +
+		first.Name() + ",Artist 1,Title 1,x\n" +
+		"/nonexistent/file.mp3,Artist 2,Title 2,x\n" +
+		second.Name() + ",Artist 3,,x\n"
+
+	report, err := ApplyCSV(strings.NewReader(csvData), CSVOptions{
+		PathColumn: "file",
+		Columns:    map[string]string{"artist": "Artist", "title": "Title"},
+		Workers:    2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Applied != 2 {
+		t.Errorf("Expected %v applied rows, got %v", 2, report.Applied)
+	}
+
+	if len(report.Errors) != 1 || report.Errors[0].Row != 3 {
+		t.Fatalf("Expected one error for row 3, got %v", report.Errors)
+	}
+
+	tests := []struct {
+		path   string
+		artist string
+		title  string
+	}{
+		{first.Name(), "Artist 1", "Title 1"},
+		{second.Name(), "Artist 3", "Title"},
+	}
+
+	for _, tt := range tests {
+		tag, err := Open(tt.path, parseOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if tag.Artist() != tt.artist {
+			t.Errorf("Expected %v, got %v", tt.artist, tag.Artist())
+		}
+
+		if tag.Title() != tt.title {
+			t.Errorf("Expected %v, got %v", tt.title, tag.Title())
+		}
+
+		tag.Close()
+	}
+}
+
+func TestApplyCSVNoPathColumn(t *testing.T) {
+	t.Parallel()
+
+	_, err := ApplyCSV(strings.NewReader("artist\nfoo\n"), CSVOptions{})
+	if !errors.Is(err, ErrNoPathColumn) {
+		t.Errorf("Expected %v, got %v", ErrNoPathColumn, err)
+	}
+}
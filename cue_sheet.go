@@ -0,0 +1,194 @@
+package id3v2
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cueSheetFramesPerSecond is the number of CD frames in a second used by INDEX entries.
+const cueSheetFramesPerSecond = 75
+
+// ErrInvalidCueSheet is returned when a CUE sheet contains a malformed TRACK or INDEX entry.
+var ErrInvalidCueSheet = errors.New("invalid CUE sheet")
+
+// cueSheetIndexPattern is a regex pattern to match INDEX entries (e.g., INDEX 01 03:25:40).
+var cueSheetIndexPattern = regexp.MustCompile(`^(\d+)\s+(\d+):(\d{2}):(\d{2})$`)
+
+// CueSheet holds the result of parsing a CUE sheet.
+type CueSheet struct {
+	Title           string               // The title of the album (or the mix).
+	Performer       string               // The performer of the album.
+	Chapters        []ChapterFrame       // A chapter for every track.
+	TableOfContents TableOfContentsFrame // A top-level table of contents listing all chapters.
+}
+
+// ParseCueSheet reads and parses a CUE sheet (.cue) from the provided io.Reader.
+// Every TRACK becomes a chapter starting at its INDEX 01 entry (or INDEX 00 if there is no INDEX 01)
+// and ending where the next track starts. The track title becomes the chapter title
+// and the track performer becomes the chapter description.
+// The end time of the last chapter is unknown from the CUE sheet, so it equals its start time.
+// Set it to the duration of the audio (e.g., from tag.AudioInfo) before adding the chapters to a tag.
+func ParseCueSheet(inputReader io.Reader) (CueSheet, error) {
+	lines, err := readLinesFromReader(inputReader,
+		func(sourceLine string) (string, bool) {
+			resultLine := strings.TrimSpace(strings.TrimPrefix(sourceLine, "\uFEFF"))
+			isLineSkipped := resultLine == "" // Skip empty lines.
+
+			return resultLine, isLineSkipped
+		})
+	if err != nil {
+		return CueSheet{}, err
+	}
+
+	var (
+		result CueSheet
+		tracks []cueSheetTrack
+	)
+
+	for _, line := range lines {
+		command, arguments, _ := strings.Cut(line, " ")
+		arguments = strings.TrimSpace(arguments)
+
+		// Commands before the first TRACK describe the whole album.
+		var current *cueSheetTrack
+		if len(tracks) > 0 {
+			current = &tracks[len(tracks)-1]
+		}
+
+		switch strings.ToUpper(command) {
+		case "TITLE":
+			if current == nil {
+				result.Title = unquoteCueSheetValue(arguments)
+			} else {
+				current.title = unquoteCueSheetValue(arguments)
+			}
+		case "PERFORMER":
+			if current == nil {
+				result.Performer = unquoteCueSheetValue(arguments)
+			} else {
+				current.performer = unquoteCueSheetValue(arguments)
+			}
+		case "TRACK":
+			number, _, _ := strings.Cut(arguments, " ")
+			if _, err = strconv.Atoi(number); err != nil {
+				return CueSheet{}, fmt.Errorf("%w: %q", ErrInvalidCueSheet, line)
+			}
+
+			tracks = append(tracks, cueSheetTrack{number: number, start: -1})
+		case "INDEX":
+			if current == nil {
+				return CueSheet{}, fmt.Errorf("%w: INDEX outside of TRACK", ErrInvalidCueSheet)
+			}
+
+			if err = current.parseIndex(arguments); err != nil {
+				return CueSheet{}, fmt.Errorf("%w: %q", err, line)
+			}
+		}
+	}
+
+	result.TableOfContents = TableOfContentsFrame{
+		ElementID: defaultTableOfContentsElementID,
+		TopLevel:  true,
+		Ordered:   true,
+	}
+
+	if result.Title != "" {
+		result.TableOfContents.Title = &TextFrame{Encoding: EncodingUTF8, Text: result.Title}
+	}
+
+	for i, track := range tracks {
+		if track.start < 0 {
+			return CueSheet{}, fmt.Errorf("%w: TRACK %s has no INDEX", ErrInvalidCueSheet, track.number)
+		}
+
+		// The chapter ends where the next track starts.
+		end := track.start
+		if i+1 < len(tracks) && tracks[i+1].start > track.start {
+			end = tracks[i+1].start
+		}
+
+		chapter := ChapterFrame{
+			ElementID:   defaultChapterElementIDPrefix + strconv.Itoa(i+1),
+			StartTime:   track.start,
+			EndTime:     end,
+			StartOffset: IgnoredOffset,
+			EndOffset:   IgnoredOffset,
+		}
+
+		if track.title != "" {
+			chapter.Title = &TextFrame{Encoding: EncodingUTF8, Text: track.title}
+		}
+
+		if track.performer != "" {
+			chapter.Description = &TextFrame{Encoding: EncodingUTF8, Text: track.performer}
+		}
+
+		result.Chapters = append(result.Chapters, chapter)
+		result.TableOfContents.ChildElementIDs = append(result.TableOfContents.ChildElementIDs, chapter.ElementID)
+	}
+
+	return result, nil
+}
+
+// AddToTag adds the chapters and the table of contents to the tag.
+func (cs CueSheet) AddToTag(tag *Tag) {
+	for _, chapter := range cs.Chapters {
+		tag.AddChapterFrame(chapter)
+	}
+
+	tag.AddTableOfContentsFrame(cs.TableOfContents)
+}
+
+// cueSheetTrack holds the values of a single TRACK entry of a CUE sheet.
+type cueSheetTrack struct {
+	number    string
+	title     string
+	performer string
+	start     time.Duration // The start time, negative if there is no INDEX entry yet.
+	hasIndex1 bool          // Whether the start time comes from INDEX 01.
+}
+
+// parseIndex parses the arguments of an INDEX entry (e.g., 01 03:25:40) and updates the start time.
+// INDEX 01 marks the start of the track, INDEX 00 (the pregap) is used only if there is no INDEX 01.
+func (track *cueSheetTrack) parseIndex(arguments string) error {
+	match := cueSheetIndexPattern.FindStringSubmatch(strings.Join(strings.Fields(arguments), " "))
+	if len(match) != 5 {
+		return ErrInvalidCueSheet
+	}
+
+	number, _ := strconv.Atoi(match[1])
+	minutes, _ := strconv.ParseInt(match[2], 10, 64)
+	seconds, _ := strconv.ParseInt(match[3], 10, 64)
+	frames, _ := strconv.ParseInt(match[4], 10, 64)
+
+	if seconds >= 60 || frames >= cueSheetFramesPerSecond {
+		return ErrInvalidCueSheet
+	}
+
+	start := time.Duration(minutes*60+seconds)*time.Second +
+		time.Duration(frames)*time.Second/cueSheetFramesPerSecond
+
+	switch {
+	case number == 1:
+		track.start = start
+		track.hasIndex1 = true
+	case number == 0 && !track.hasIndex1:
+		track.start = start
+	}
+
+	return nil
+}
+
+// unquoteCueSheetValue removes the surrounding double quotes from a CUE sheet value.
+func unquoteCueSheetValue(value string) string {
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
@@ -0,0 +1,80 @@
+package id3v2
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCueSheet(t *testing.T) {
+	t.Parallel()
+
+	cueContent := `REM GENRE Electronic
+PERFORMER "DJ Someone"
+TITLE "Live Mix"
+FILE "mix.mp3" MP3
+  TRACK 01 AUDIO
+    TITLE "Intro"
+    PERFORMER "Artist A"
+    INDEX 01 00:00:00
+  TRACK 02 AUDIO
+    TITLE "Second"
+    INDEX 00 03:20:00
+    INDEX 01 03:25:40
+`
+
+	cs, err := ParseCueSheet(strings.NewReader(cueContent))
+	if err != nil {
+		t.Fatalf("Error parsing CUE sheet: %v", err)
+	}
+
+	if cs.Title != "Live Mix" || cs.Performer != "DJ Someone" {
+		t.Errorf("Unexpected album values: %q, %q", cs.Title, cs.Performer)
+	}
+
+	if len(cs.Chapters) != 2 {
+		t.Fatalf("Expected 2 chapters, got %v", len(cs.Chapters))
+	}
+
+	secondStart := 3*time.Minute + 25*time.Second + 40*time.Second/75
+
+	first := cs.Chapters[0]
+	if first.ElementID != "chp1" || first.StartTime != 0 || first.EndTime != secondStart {
+		t.Errorf("Unexpected first chapter: %+v", first)
+	}
+
+	if first.Title.Text != "Intro" || first.Description.Text != "Artist A" {
+		t.Errorf("Unexpected first chapter title and description: %v, %v", first.Title, first.Description)
+	}
+
+	second := cs.Chapters[1]
+	if second.StartTime != secondStart || second.EndTime != secondStart || second.Description != nil {
+		t.Errorf("Unexpected second chapter: %+v", second)
+	}
+
+	if strings.Join(cs.TableOfContents.ChildElementIDs, ",") != "chp1,chp2" || !cs.TableOfContents.TopLevel {
+		t.Errorf("Unexpected table of contents: %+v", cs.TableOfContents)
+	}
+
+	tag := NewEmptyTag()
+	cs.AddToTag(tag)
+
+	if tag.Count() != 3 {
+		t.Errorf("Expected 3 frames, got %v", tag.Count())
+	}
+}
+
+func TestParseCueSheetInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, cueContent := range []string{
+		"INDEX 01 00:00:00",
+		"TRACK 01 AUDIO\nINDEX 01 00:00:99",
+		"TRACK 01 AUDIO\nTITLE \"No index\"",
+	} {
+		if _, err := ParseCueSheet(strings.NewReader(cueContent)); !errors.Is(err, ErrInvalidCueSheet) {
+			t.Errorf("Expected %v for %q, got %v", ErrInvalidCueSheet, cueContent, err)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package id3v2
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrGarbageInPadding is reported by tag.DamagedRanges for non-zero bytes in the padding of a tag.
+var ErrGarbageInPadding = errors.New("non-zero bytes in padding")
+
+// DamagedRange describes bytes of a tag that couldn't be interpreted while parsing, see Options.Forensic.
+type DamagedRange struct {
+	Offset int64 // The offset of the first byte in the file.
+	Size   int64 // The number of bytes.
+	Err    error // The reason why the bytes couldn't be interpreted.
+}
+
+// DamagedRanges returns the byte ranges of the tag that couldn't be interpreted while parsing
+// with Options.Forensic, in the order of occurrence: malformed frames, bytes skipped to find
+// the next frame and garbage in the padding. It returns nil if nothing is damaged
+// or forensic mode wasn't enabled.
+func (tag *Tag) DamagedRanges() []DamagedRange {
+	return tag.damagedRanges
+}
+
+// checkPadding reads the padding, whose first bytes are the blank frame header in buf
+// read at the offset, until the end of the tag area of the remaining size,
+// and records the range from the first to the last non-zero byte as damaged.
+// A file ending within the padding isn't an error.
+func (tag *Tag) checkPadding(rd io.Reader, buf []byte, offset, remaining int64) error {
+	first, last := int64(-1), int64(-1)
+
+	scan := func(data []byte, at int64) {
+		for i, b := range data {
+			if b == 0 {
+				continue
+			}
+
+			if first < 0 {
+				first = at + int64(i)
+			}
+
+			last = at + int64(i)
+		}
+	}
+
+	header := min(remaining, frameHeaderSize)
+	scan(buf[:header], offset)
+
+	for at := offset + header; at < offset+remaining; {
+		n, err := io.ReadFull(rd, buf[:min(int64(len(buf)), offset+remaining-at)])
+		scan(buf[:n], at)
+		at += int64(n)
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if first >= 0 {
+		tag.damagedRanges = append(tag.damagedRanges, DamagedRange{
+			Offset: first,
+			Size:   last - first + 1,
+			Err:    ErrGarbageInPadding,
+		})
+	}
+
+	return nil
+}
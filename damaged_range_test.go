@@ -0,0 +1,68 @@
+package id3v2
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDamagedRanges(t *testing.T) {
+	t.Parallel()
+
+	frame := func(id string, body []byte) []byte {
+		header := []byte(id)
+		header = append(header, 0, 0, 0, byte(len(body)), 0, 0)
+
+		return append(header, body...)
+	}
+
+	title := frame("TIT2", []byte("\x03Title"))
+	garbled := frame("ab!d", []byte("junk"))
+	resync := []byte{'C', 'O', 'M', 'M', 0x80, 0x80, 0x80, 0x80, 0, 0, 'x'}
+	artist := frame("TPE1", []byte("\x03Artist"))
+
+	padding := make([]byte, 30)
+	padding[15], padding[20] = 0x55, 0x55
+
+	frames := concat(title, garbled, resync, artist, padding)
+	data := concat([]byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, byte(len(frames))}, frames)
+
+	tag, err := ParseBytes(data, Options{Parse: true, Forensic: true})
+	if err != nil {
+		t.Fatal("Error while parsing tag:", err)
+	}
+
+	if tag.Title() != "Title" || tag.Artist() != "Artist" {
+		t.Errorf("Expected %v and %v, got %v and %v", "Title", "Artist", tag.Title(), tag.Artist())
+	}
+
+	garbledOffset := int64(tagHeaderSize + len(title))
+	resyncOffset := garbledOffset + int64(len(garbled))
+	paddingOffset := resyncOffset + int64(len(resync)+len(artist))
+
+	expected := []DamagedRange{
+		{Offset: garbledOffset, Size: int64(len(garbled)), Err: ErrInvalidFrameID},
+		{Offset: resyncOffset, Size: int64(len(resync)), Err: ErrInvalidSizeFormat},
+		{Offset: paddingOffset + 15, Size: 6, Err: ErrGarbageInPadding},
+	}
+
+	ranges := tag.DamagedRanges()
+	if len(ranges) != len(expected) {
+		t.Fatalf("Expected %d damaged ranges, got %+v", len(expected), ranges)
+	}
+
+	for i, r := range ranges {
+		if r.Offset != expected[i].Offset || r.Size != expected[i].Size || !errors.Is(r.Err, expected[i].Err) {
+			t.Errorf("Expected %+v, got %+v", expected[i], r)
+		}
+	}
+
+	// Without forensic mode, no ranges are recorded.
+	tag, err = ParseBytes(data, Options{Parse: true, Lenient: true})
+	if err != nil {
+		t.Fatal("Error while parsing tag:", err)
+	}
+
+	if ranges = tag.DamagedRanges(); ranges != nil {
+		t.Errorf("Expected no damaged ranges, got %+v", ranges)
+	}
+}
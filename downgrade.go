@@ -0,0 +1,179 @@
+package id3v2
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// FrameChangeKind is the kind of change made to a frame by tag.ConvertToV23.
+type FrameChangeKind int
+
+// Kinds of frame changes.
+const (
+	// FrameRemapped marks a frame moved to the frame ID of its ID3v2.3 equivalent.
+	FrameRemapped FrameChangeKind = iota
+	// FrameReencoded marks a frame whose text encoding isn't supported by ID3v2.3.
+	FrameReencoded
+	// FrameDropped marks a frame that has no ID3v2.3 equivalent.
+	FrameDropped
+)
+
+// String returns the name of the kind of change.
+func (k FrameChangeKind) String() string {
+	switch k {
+	case FrameRemapped:
+		return "remapped"
+	case FrameReencoded:
+		return "re-encoded"
+	case FrameDropped:
+		return "dropped"
+	default:
+		return fmt.Sprintf("FrameChangeKind(%d)", int(k))
+	}
+}
+
+// FrameChange describes a change made to a frame by tag.ConvertToV23.
+type FrameChange struct {
+	Kind    FrameChangeKind // The kind of change.
+	FrameID string          // The ID of the changed frame.
+	NewID   string          // The ID the frame was moved to, for remapped frames.
+	Message string          // A human-readable description of the change.
+}
+
+// String returns the change formatted as "kind: frame ID: message".
+func (fc FrameChange) String() string {
+	return fc.Kind.String() + ": " + fc.FrameID + ": " + fc.Message
+}
+
+// ConvertToV23 converts the tag to ID3v2.3 and returns a report of every changed frame ordered by frame ID,
+// so automated pipelines can log exactly what changed:
+//   - the recording time (TDRC) is moved to TYER, TDAT and TIME, see tag.SetReleaseDate,
+//     the original release time (TDOR) to TORY and the involved people (TIPL) to IPLS;
+//   - frames encoded in UTF-8 or UTF-16BE are re-encoded in UTF-16;
+//   - the other ID3v2.4 frames (e.g., TSST or TMOO) have no ID3v2.3 equivalent and are dropped,
+//     and so are malformed dates.
+//
+// Saving a tag whose version was changed with SetVersion keeps the ID3v2.4 frames,
+// which ID3v2.3 readers ignore.
+func (tag *Tag) ConvertToV23() []FrameChange {
+	if tag.rejectChange() {
+		return nil
+	}
+
+	var changes []FrameChange
+
+	if tag.Version() == 3 {
+		return changes
+	}
+
+	addChange := func(kind FrameChangeKind, id, newID, format string, args ...any) {
+		changes = append(changes, FrameChange{Kind: kind, FrameID: id, NewID: newID, Message: fmt.Sprintf(format, args...)})
+	}
+
+	release, releaseErr := tag.ReleaseDate()
+	original, originalErr := tag.OriginalReleaseDate()
+	involvedPeople := tag.involvedPeople()
+
+	for _, id := range []string{"TDRC", "TDOR", "TIPL"} {
+		tag.DeleteFrames(id)
+	}
+
+	tag.SetVersion(3)
+
+	switch {
+	case releaseErr != nil:
+		addChange(FrameDropped, "TDRC", "", "malformed date: %v", releaseErr)
+	case !release.IsZero():
+		tag.SetReleaseDate(release)
+		addChange(FrameRemapped, "TDRC", "TYER", "date %q moved to TYER, TDAT and TIME", release)
+	}
+
+	switch {
+	case originalErr != nil:
+		addChange(FrameDropped, "TDOR", "", "malformed date: %v", originalErr)
+	case !original.IsZero():
+		tag.SetOriginalReleaseDate(original)
+		addChange(FrameRemapped, "TDOR", "TORY", "date %q moved to TORY as year", original)
+	}
+
+	if len(involvedPeople.Multi) > 0 {
+		tag.AddFrame("IPLS", involvedPeople)
+		addChange(FrameRemapped, "TIPL", "IPLS", "%d role and person pairs moved to IPLS", len(involvedPeople.Multi)/2)
+	}
+
+	for _, id := range v24OnlyFrameIDs {
+		if frames := tag.GetFrames(id); len(frames) > 0 {
+			tag.DeleteFrames(id)
+			addChange(FrameDropped, id, "", "frame has no ID3v2.3 equivalent, %d removed", len(frames))
+		}
+	}
+
+	for id, frames := range tag.AllFrames() {
+		for _, frame := range frames {
+			encoding, ok := frameEncoding(frame)
+			if ok && !encoding.Equals(EncodingISO) && !encoding.Equals(EncodingUTF16) {
+				addChange(FrameReencoded, id, "", "encoding changed from %s to %s", encoding, EncodingUTF16)
+			}
+		}
+	}
+
+	tag.reencode(func(encoding Encoding) bool {
+		return !encoding.Equals(EncodingISO) && !encoding.Equals(EncodingUTF16)
+	}, EncodingUTF16)
+
+	slices.SortStableFunc(changes, func(a, b FrameChange) int {
+		return cmp.Compare(a.FrameID, b.FrameID)
+	})
+
+	return changes
+}
+
+// reencode switches the frames whose encoding matches to the encoding.
+func (tag *Tag) reencode(match func(Encoding) bool, to Encoding) {
+	apply := func(f Framer) Framer {
+		if encoding, ok := frameEncoding(f); ok && match(encoding) {
+			return withEncoding(f, to)
+		}
+
+		return f
+	}
+
+	for id, frame := range tag.frames {
+		tag.frames[id] = tag.track(id, frame, apply(frame))
+	}
+
+	for id, s := range tag.sequences {
+		for i, frame := range s.frames {
+			s.frames[i] = tag.track(id, frame, apply(frame))
+		}
+	}
+}
+
+// involvedPeople returns the text frame holding the role and person pairs of all involved people lists (TIPL)
+// of the tag in the encoding of the first one, so none of them is lost when they are moved to IPLS.
+// A role without a person is completed with an empty one, as IPLS stores the values in pairs as well.
+func (tag *Tag) involvedPeople() TextFrame {
+	var involvedPeople TextFrame
+
+	for _, f := range tag.GetFrames("TIPL") {
+		tf, ok := f.(TextFrame)
+		if !ok {
+			continue
+		}
+
+		pairs := tf.Values()
+		if len(pairs)%2 == 1 {
+			pairs = append(pairs, "")
+		}
+
+		if len(involvedPeople.Multi) == 0 {
+			involvedPeople.Encoding = tf.Encoding
+			involvedPeople.Text = pairs[0]
+		}
+
+		involvedPeople.Multi = append(involvedPeople.Multi, pairs...)
+	}
+
+	return involvedPeople
+}
@@ -0,0 +1,97 @@
+package id3v2
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestConvertToV23(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetVersion(4)
+	tag.SetTitle("Title")
+	tag.SetReleaseDate(Timestamp{Time: time.Date(2024, time.May, 17, 0, 0, 0, 0, time.UTC), Precision: PrecisionDay})
+	tag.AddTextFrame("TSST", EncodingUTF8, "Disc one")
+	tag.AddTextFrame("TMOO", EncodingUTF8, "Calm")
+	tag.AddTextFrame("TIPL", EncodingUTF8, "producer")
+	tag.AddTextFrame("TPE1", EncodingISO, "Artist")
+
+	changes := tag.ConvertToV23()
+
+	expected := []struct {
+		kind  FrameChangeKind
+		id    string
+		newID string
+	}{
+		{FrameReencoded, "IPLS", ""},
+		{FrameRemapped, "TDRC", "TYER"},
+		{FrameRemapped, "TIPL", "IPLS"},
+		{FrameReencoded, "TIT2", ""},
+		{FrameDropped, "TMOO", ""},
+		{FrameDropped, "TSST", ""},
+	}
+
+	if len(changes) != len(expected) {
+		t.Fatalf("Expected %d changes, got %v", len(expected), changes)
+	}
+
+	for i, e := range expected {
+		if changes[i].Kind != e.kind || changes[i].FrameID != e.id || changes[i].NewID != e.newID {
+			t.Errorf("Expected %s change of %s to %q, got %v", e.kind, e.id, e.newID, changes[i])
+		}
+	}
+
+	if tag.Version() != 3 {
+		t.Errorf("Expected version 3, got %d", tag.Version())
+	}
+
+	if tag.GetTextFrame("TYER").Text != "2024" || tag.GetTextFrame("TDAT").Text != "1705" {
+		t.Errorf("Expected the date in TYER and TDAT, got frames %v", tag.AllFrames())
+	}
+
+	if issues := tag.Validate(); len(issues) != 0 {
+		t.Errorf("Expected a valid ID3v2.3 tag, got %v", issues)
+	}
+
+	if changes = tag.ConvertToV23(); len(changes) != 0 {
+		t.Errorf("Expected no changes for an ID3v2.3 tag, got %v", changes)
+	}
+}
+
+// TestConvertToV23InvolvedPeople checks that all role and person pairs of TIPL are moved to IPLS.
+func TestConvertToV23InvolvedPeople(t *testing.T) {
+	t.Parallel()
+
+	people := []string{"producer", "Анна", "mixer", "Bob"}
+
+	tag := NewEmptyTag()
+	tag.SetVersion(4)
+	tag.AddFrame("TIPL", TextFrame{Encoding: EncodingUTF8, Text: people[0], Multi: people})
+
+	changes := tag.ConvertToV23()
+	if len(changes) != 2 || changes[1].Kind != FrameRemapped || changes[1].Message != "2 role and person pairs moved to IPLS" {
+		t.Errorf("Expected the pairs to be reported as moved, got %v", changes)
+	}
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := ParseBytes(data, Options{Parse: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// IPLS isn't parsed as a text frame, so its body is decoded here.
+	ipls, _ := parsed.GetLastFrame("IPLS").(UnknownFrame)
+	if len(ipls.Body) == 0 {
+		t.Fatalf("Expected IPLS to be written, got %v", parsed.AllFrames())
+	}
+
+	if values := decodeMulti(ipls.Body[1:], getEncoding(ipls.Body[0])); !slices.Equal(values, people) {
+		t.Errorf("Expected involved people %q, got %q", people, values)
+	}
+}
@@ -2,13 +2,30 @@ package id3v2
 
 import (
 	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"io"
+	"strings"
+	"unicode/utf16"
 
 	"golang.org/x/text/encoding"
 	"golang.org/x/text/encoding/charmap"
 	"golang.org/x/text/encoding/unicode"
 )
 
+const (
+	// maxISORune is the largest character that can be represented in ISO-8859-1.
+	maxISORune = 0xFF
+
+	// substitutionRune replaces characters that can't be represented in the target encoding.
+	substitutionRune = '?'
+)
+
+// ErrUnencodableText is returned when a text contains characters that can't be represented in its encoding,
+// e.g. Cyrillic letters in ISO-8859-1.
+var ErrUnencodableText = errors.New("text can't be represented in the encoding")
+
 // Encoding represents a text encoding used in ID3v2 tags.
 // It includes the encoding name, a key (used in ID3v2 frames), and the termination bytes
 // that mark the end of a string in this encoding.
@@ -67,9 +84,6 @@ var (
 	// xEncodingUTF16BEBOM is the Go encoding for UTF-16 with Big Endian and BOM.
 	xEncodingUTF16BEBOM = unicode.UTF16(unicode.BigEndian, unicode.ExpectBOM)
 
-	// xEncodingUTF16LEBOM is the Go encoding for UTF-16 with Little Endian and BOM.
-	xEncodingUTF16LEBOM = unicode.UTF16(unicode.LittleEndian, unicode.ExpectBOM)
-
 	// xEncodingUTF16BE is the Go encoding for UTF-16 with Big Endian and no BOM.
 	xEncodingUTF16BE = unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM)
 
@@ -81,6 +95,14 @@ var (
 // See https://en.wikipedia.org/wiki/Byte_order_mark.
 var bom = []byte{0xFF, 0xFE}
 
+// bomBE is the Byte Order Mark (BOM) of big-endian UTF-16, written by this library.
+var bomBE = []byte{0xFE, 0xFF}
+
+// isBOM reports whether b is the Byte Order Mark of UTF-16 in either byte order.
+func isBOM(b []byte) bool {
+	return bytes.Equal(b, bom) || bytes.Equal(b, bomBE)
+}
+
 // getEncoding returns the Encoding corresponding to the given ID3v2 key.
 // If the key is invalid, it defaults to EncodingUTF8.
 func getEncoding(key byte) Encoding {
@@ -93,6 +115,8 @@ func getEncoding(key byte) Encoding {
 
 // encodedSize calculates the length of the UTF-8 string `src` when encoded into the specified `enc`.
 // If the encoding is already UTF-8, it returns the length of the string as is.
+// Characters that can't be represented in `enc` are counted as substituted,
+// which matches the output written with WriteOptions.SubstituteUnencodable.
 func encodedSize(src string, enc Encoding) int {
 	if enc.Equals(EncodingUTF8) {
 		return len(src)
@@ -102,51 +126,145 @@ func encodedSize(src string, enc Encoding) int {
 	bw := getBufWriter(io.Discard)
 	defer putBufWriter(bw)
 
-	err := encodeWriteText(bw, src, enc)
-	if err != nil {
-		panic(err) // Panic if encoding fails, as this should never happen in normal usage.
+	if err := encodeWriteText(bw, src, enc); err != nil {
+		bw.Reset(io.Discard)
+
+		_ = encodeWriteText(bw, substituteUnencodable(src, enc), enc)
 	}
 
 	return bw.Written()
 }
 
+// encodedValuesSize calculates the size of the values written with EncodeAndWriteValues.
+func encodedValuesSize(values []string, enc Encoding) int {
+	bw := getBufWriter(io.Discard)
+	defer putBufWriter(bw)
+
+	bw.substitute = true
+	bw.EncodeAndWriteValues(values, enc)
+
+	return bw.Written()
+}
+
+// checkEncodable returns ErrUnencodableText if `src` contains characters that can't be represented in `enc`.
+// Only ISO-8859-1 is limited, all other encodings cover the whole Unicode.
+func checkEncodable(src string, enc Encoding) error {
+	if !enc.Equals(EncodingISO) {
+		return nil
+	}
+
+	for _, r := range src {
+		if r > maxISORune {
+			return fmt.Errorf("%w: %q in %s", ErrUnencodableText, r, enc)
+		}
+	}
+
+	return nil
+}
+
+// substituteUnencodable replaces the characters of `src` that can't be represented in `enc`
+// with substitutionRune.
+func substituteUnencodable(src string, enc Encoding) string {
+	if !enc.Equals(EncodingISO) {
+		return src
+	}
+
+	return strings.Map(func(r rune) rune {
+		if r > maxISORune {
+			return substitutionRune
+		}
+
+		return r
+	}, src)
+}
+
 // decodeText decodes the byte slice `src` from the specified `from` encoding into a UTF-8 string.
 // It removes the termination bytes and handles special cases like BOM in UTF-16.
 func decodeText(src []byte, from Encoding) string {
+	switch {
+	case from.Equals(EncodingUTF16):
+		return decodeUTF16(src, nil)
+	case from.Equals(EncodingUTF16BE):
+		return decodeUTF16(src, binary.BigEndian)
+	}
+
 	src = bytes.TrimSuffix(src, from.TerminationBytes) // Remove termination bytes.
 
 	if from.Equals(EncodingUTF8) {
 		return string(src) // No decoding needed for UTF-8.
 	}
 
-	// If the source is just a BOM, return an empty string.
-	if from.Equals(EncodingUTF16) && bytes.Equal(src, bom) {
-		return ""
-	}
-
-	// Resolve the Go encoding for the specified ID3v2 encoding.
-	fromXEncoding := resolveXEncoding(src, from)
-
 	// Decode the byte slice into a UTF-8 string.
-	result, err := fromXEncoding.NewDecoder().Bytes(src)
+	result, err := resolveXEncoding(from).NewDecoder().Bytes(src)
 	if err != nil {
 		return string(src) // Fallback to raw bytes if decoding fails.
 	}
 
-	// HACK: Remove the REPLACEMENT CHARACTER (�) if encoding went wrong.
-	// This is a workaround for malformed UTF-16 data.
-	if from.Equals(EncodingUTF16) {
-		result = bytes.ReplaceAll(result, []byte{0xEF, 0xBF, 0xBD}, []byte{})
+	return string(result)
+}
+
+// decodeUTF16 decodes the UTF-16 string `src` into a UTF-8 string.
+// The byte order is detected from the BOM of the string, so every value of a frame may have its own.
+// Without a BOM, the byte order is `order`, or it's guessed if `order` is nil.
+// An odd trailing byte isn't a complete code unit and is dropped,
+// and unpaired surrogates are decoded as the replacement character (U+FFFD).
+func decodeUTF16(src []byte, order binary.ByteOrder) string {
+	src = src[:len(src)-len(src)%2]
+	src = bytes.TrimSuffix(src, EncodingUTF16.TerminationBytes) // Remove termination bytes.
+
+	switch {
+	case bytes.HasPrefix(src, bom):
+		order, src = binary.LittleEndian, src[len(bom):]
+	case bytes.HasPrefix(src, bomBE):
+		order, src = binary.BigEndian, src[len(bomBE):]
+	case order == nil:
+		order = guessUTF16ByteOrder(src)
 	}
 
-	return string(result)
+	units := make([]uint16, len(src)/2)
+	for i := range units {
+		units[i] = order.Uint16(src[2*i:])
+	}
+
+	return string(utf16.Decode(units))
+}
+
+// guessUTF16ByteOrder guesses the byte order of the UTF-16 string `src` without a BOM.
+// Latin text has zero high bytes, so more zero bytes at even offsets mean big-endian.
+// Otherwise it's little-endian, the byte order of the writers that omit the BOM, as they're mostly Windows ones.
+func guessUTF16ByteOrder(src []byte) binary.ByteOrder {
+	var evenZeros, oddZeros int
+
+	for i, b := range src {
+		if b != 0 {
+			continue
+		}
+
+		if i%2 == 0 {
+			evenZeros++
+		} else {
+			oddZeros++
+		}
+	}
+
+	if evenZeros > oddZeros {
+		return binary.BigEndian
+	}
+
+	return binary.LittleEndian
 }
 
 // decodeMulti decodes a multi-valued byte slice `src` from the specified `from` encoding into a slice of UTF-8 strings.
 // It splits the byte slice using the termination bytes and decodes each part.
 func decodeMulti(src []byte, from Encoding) []string {
-	src = bytes.TrimSuffix(src, from.TerminationBytes)
-	splitted := bytes.Split(src, from.TerminationBytes) // Split into parts.
+	var splitted [][]byte
+
+	if len(from.TerminationBytes) == 2 {
+		splitted = splitUTF16(src)
+	} else {
+		src = bytes.TrimSuffix(src, from.TerminationBytes)
+		splitted = bytes.Split(src, from.TerminationBytes) // Split into parts.
+	}
 
 	res := make([]string, 0, len(splitted))
 	for _, s := range splitted {
@@ -156,6 +274,27 @@ func decodeMulti(src []byte, from Encoding) []string {
 	return res
 }
 
+// splitUTF16 splits the UTF-16 values of `src` separated by the termination bytes.
+// The termination bytes are only searched for at the boundaries of the code units.
+func splitUTF16(src []byte) [][]byte {
+	src = src[:len(src)-len(src)%2]
+	src = bytes.TrimSuffix(src, EncodingUTF16.TerminationBytes)
+
+	var (
+		splitted [][]byte
+		start    int
+	)
+
+	for i := 0; i < len(src); i += 2 {
+		if src[i] == 0 && src[i+1] == 0 {
+			splitted = append(splitted, src[start:i])
+			start = i + 2
+		}
+	}
+
+	return append(splitted, src[start:])
+}
+
 // encodeWriteText encodes the UTF-8 string `src`
 // into the specified `to` encoding and writes it to the buffered writer `bw`.
 // It handles special cases like adding a null terminator for UTF-16.
@@ -167,7 +306,7 @@ func encodeWriteText(bw *bufferedWriter, src string, to Encoding) error {
 	}
 
 	// Resolve the Go encoding for the specified ID3v2 encoding.
-	toXEncoding := resolveXEncoding(nil, to)
+	toXEncoding := resolveXEncoding(to)
 
 	// Encode the string into the target encoding.
 	encoded, err := toXEncoding.NewEncoder().String(src)
@@ -177,30 +316,45 @@ func encodeWriteText(bw *bufferedWriter, src string, to Encoding) error {
 
 	bw.WriteString(encoded)
 
-	// Add a null terminator for UTF-16 if it's missing.
-	if to.Equals(EncodingUTF16) && !bytes.HasSuffix([]byte(encoded), []byte{0}) {
-		bw.WriteByte(0)
-	}
-
 	return nil
 }
 
 // resolveXEncoding resolves the Go encoding for the specified ID3v2 encoding.
-// It handles special cases like detecting the BOM in UTF-16.
-func resolveXEncoding(src []byte, encoding Encoding) encoding.Encoding {
+// UTF-16 with BOM is written in big-endian.
+func resolveXEncoding(encoding Encoding) encoding.Encoding {
 	switch encoding.Key {
 	case 0:
 		return xEncodingISO // ISO-8859-1.
 	case 1:
-		// If the source starts with a BOM, use Little Endian; otherwise, use Big Endian.
-		if len(src) > 2 && bytes.Equal(src[:2], bom) {
-			return xEncodingUTF16LEBOM
-		}
-
-		return xEncodingUTF16BEBOM
+		return xEncodingUTF16BEBOM // UTF-16 Big Endian with BOM.
 	case 2:
 		return xEncodingUTF16BE // UTF-16 Big Endian without BOM.
 	default:
 		return xEncodingUTF8 // Default to UTF-8.
 	}
 }
+
+// fallBackToUTF16 switches the frames whose text can't be represented in ISO-8859-1 to UTF-16,
+// logging a warning for each of them.
+func (tag *Tag) fallBackToUTF16() {
+	fallBack := func(id string, f Framer) Framer {
+		encoding, ok := frameEncoding(f)
+		if !ok || !encoding.Equals(EncodingISO) || fitsISO(frameTexts(f)) {
+			return f
+		}
+
+		tag.writeOptions.logWarn("text can't be represented in ISO-8859-1, switching frame to UTF-16", "id", id)
+
+		return withEncoding(f, EncodingUTF16)
+	}
+
+	for id, frame := range tag.frames {
+		tag.frames[id] = tag.track(id, frame, fallBack(id, frame))
+	}
+
+	for id, s := range tag.sequences {
+		for i, frame := range s.frames {
+			s.frames[i] = tag.track(id, frame, fallBack(id, frame))
+		}
+	}
+}
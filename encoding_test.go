@@ -2,6 +2,9 @@ package id3v2
 
 import (
 	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
 	"sync"
 	"testing"
 )
@@ -79,7 +82,7 @@ func TestEncodeWriteText(t *testing.T) {
 		expected []byte
 	}{
 		{"Héllö", EncodingISO, []byte{0x48, 0xE9, 0x6C, 0x6C, 0xF6}},
-		{"Héllö", EncodingUTF16, []byte{0xFE, 0xFF, 0x00, 0x48, 0x00, 0xE9, 0x00, 0x6C, 0x00, 0x6C, 0x00, 0xF6, 0x00}},
+		{"Héllö", EncodingUTF16, []byte{0xFE, 0xFF, 0x00, 0x48, 0x00, 0xE9, 0x00, 0x6C, 0x00, 0x6C, 0x00, 0xF6}},
 		{"Héllö", EncodingUTF16BE, []byte{0x00, 0x48, 0x00, 0xE9, 0x00, 0x6C, 0x00, 0x6C, 0x00, 0xF6}},
 	}
 
@@ -139,3 +142,281 @@ func TestUnsynchronisedLyricsFrameWithUTF16(t *testing.T) {
 		t.Errorf("Expected lyrics: %q, got: %q", lyrics, uslf.Lyrics)
 	}
 }
+
+func TestUnencodableText(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.AddTextFrame("TIT2", EncodingISO, "Песня")
+
+	if size := tag.Size(); size != tagHeaderSize+frameHeaderSize+1+len("?????")+1 {
+		t.Errorf("Expected size with substituted text, got %v", size)
+	}
+
+	if _, err := tag.SizeE(); !errors.Is(err, ErrUnencodableText) {
+		t.Errorf("Expected %v, got %v", ErrUnencodableText, err)
+	}
+
+	buf := new(bytes.Buffer)
+
+	if _, err := tag.WriteTo(buf); !errors.Is(err, ErrUnencodableText) {
+		t.Errorf("Expected %v, got %v", ErrUnencodableText, err)
+	}
+
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing written, got %v bytes", buf.Len())
+	}
+
+	tag.SetWriteOptions(WriteOptions{SubstituteUnencodable: true})
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := ParseBytes(data, parseOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parsed.Title() != "?????" {
+		t.Errorf("Expected %q, got %q", "?????", parsed.Title())
+	}
+}
+
+func TestUTF16FallbackInV23(t *testing.T) {
+	t.Parallel()
+
+	var logs bytes.Buffer
+
+	tag := NewEmptyTag()
+	tag.SetVersion(3)
+	tag.SetTitle("Песня")
+	tag.SetArtist("Artist")
+	tag.SetWriteOptions(WriteOptions{Logger: slog.New(slog.NewTextHandler(&logs, nil))})
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatalf("Error while writing tag: %v", err)
+	}
+
+	parsed, err := ParseBytes(data, parseOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parsed.Title() != "Песня" {
+		t.Errorf("Expected %q, got %q", "Песня", parsed.Title())
+	}
+
+	if encoding := parsed.GetTextFrame("TIT2").Encoding; !encoding.Equals(EncodingUTF16) {
+		t.Errorf("Expected title encoded in %s, got %s", EncodingUTF16, encoding)
+	}
+
+	if encoding := parsed.GetTextFrame("TPE1").Encoding; !encoding.Equals(EncodingISO) {
+		t.Errorf("Expected artist encoded in %s, got %s", EncodingISO, encoding)
+	}
+
+	if !strings.Contains(logs.String(), "id=TIT2") {
+		t.Errorf("Expected a warning about TIT2, got %q", logs.String())
+	}
+
+	strict := NewEmptyTag()
+	strict.SetVersion(3)
+	strict.SetTitle("Песня")
+	strict.SetWriteOptions(WriteOptions{StrictEncoding: true})
+
+	if _, err = strict.Bytes(); !errors.Is(err, ErrUnencodableText) {
+		t.Errorf("Expected %v, got %v", ErrUnencodableText, err)
+	}
+}
+
+// TestUTF16Corpus parses UTF-16 frames laid out the way real-world writers emit them.
+func TestUTF16Corpus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		id       string
+		body     []byte
+		lenient  bool
+		expected Framer
+	}{
+		{
+			name: "little-endian with BOM per string",
+			id:   "COMM",
+			body: []byte{1, 'e', 'n', 'g', 0xFF, 0xFE, 0, 0, 0xFF, 0xFE, 'T', 0, 'x', 0, 't', 0},
+			expected: CommentFrame{
+				Encoding: EncodingUTF16, Language: "eng", Text: "Txt",
+			},
+		},
+		{
+			name: "zero low byte before termination",
+			id:   "COMM",
+			body: []byte{1, 'e', 'n', 'g', 0xFF, 0xFE, 0x00, 0x4E, 0, 0, 0xFE, 0xFF, 0x4E, 0x00},
+			expected: CommentFrame{
+				Encoding: EncodingUTF16, Language: "eng", Description: "一", Text: "一",
+			},
+		},
+		{
+			name:    "extra zero byte written by older versions",
+			id:      "COMM",
+			body:    []byte{1, 'e', 'n', 'g', 0xFE, 0xFF, 0, 'D', 0, 0, 0, 0xFE, 0xFF, 0, 'T', 0},
+			lenient: true,
+			expected: CommentFrame{
+				Encoding: EncodingUTF16, Language: "eng", Description: "D", Text: "T",
+			},
+		},
+		{
+			name:    "empty description written by older versions",
+			id:      "COMM",
+			body:    []byte{1, 'e', 'n', 'g', 0xFE, 0xFF, 0, 0, 0, 0xFE, 0xFF, 0, 'T', 0},
+			lenient: true,
+			expected: CommentFrame{
+				Encoding: EncodingUTF16, Language: "eng", Text: "T",
+			},
+		},
+		{
+			name:    "picture description written by older versions",
+			id:      "APIC",
+			body:    concat([]byte{1}, []byte("image/jpeg\x00"), []byte{3, 0xFE, 0xFF, 0, 'D', 0, 0, 0, 0xFF, 0xD8, 0xFF}),
+			lenient: true,
+			expected: PictureFrame{
+				Encoding: EncodingUTF16, MimeType: "image/jpeg", PictureType: PTFrontCover,
+				Description: "D", Picture: []byte{0xFF, 0xD8, 0xFF},
+			},
+		},
+		{
+			name: "values with different byte orders",
+			id:   "TPE1",
+			body: []byte{1, 0xFF, 0xFE, 'A', 0, 0, 0, 0xFE, 0xFF, 0, 'B'},
+			expected: TextFrame{
+				Encoding: EncodingUTF16, Text: "A", Multi: []string{"A", "B"},
+			},
+		},
+		{
+			name:     "little-endian without BOM",
+			id:       "TIT2",
+			body:     []byte{1, 'A', 0, 'B', 0},
+			expected: TextFrame{Encoding: EncodingUTF16, Text: "AB"},
+		},
+		{
+			name:     "big-endian without BOM",
+			id:       "TIT2",
+			body:     []byte{1, 0, 'A', 0, 'B', 0, 0},
+			expected: TextFrame{Encoding: EncodingUTF16, Text: "AB"},
+		},
+		{
+			name:     "odd length",
+			id:       "TIT2",
+			body:     []byte{1, 0xFF, 0xFE, 'A', 0, 'B'},
+			expected: TextFrame{Encoding: EncodingUTF16, Text: "A"},
+		},
+		{
+			name:     "unpaired surrogate",
+			id:       "TIT2",
+			body:     []byte{1, 0xFF, 0xFE, 0x3D, 0xD8, 'A', 0},
+			expected: TextFrame{Encoding: EncodingUTF16, Text: "�A"},
+		},
+		{
+			name: "synchronised text with zero low byte",
+			id:   "SYLT",
+			body: []byte{1, 'e', 'n', 'g', 2, 1, 0xFF, 0xFE, 0, 0, 0xFF, 0xFE, 0x00, 0x4E, 0, 0, 0, 0, 0x03, 0xE8},
+			expected: SynchronisedLyricsFrame{
+				Encoding: EncodingUTF16, Language: "eng", TimestampFormat: SYLTAbsoluteMillisecondsTimestampFormat,
+				ContentType:       SYLTLyricsContentType,
+				SynchronizedTexts: []SynchronizedText{{Text: "一", Timestamp: 1000}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			frame := concat([]byte(tc.id), []byte{0, 0, 0, byte(len(tc.body)), 0, 0}, tc.body)
+			data := concat([]byte{'I', 'D', '3', 3, 0, 0, 0, 0, 0, byte(len(frame))}, frame)
+
+			tag, err := ParseBytes(data, Options{Parse: true, Lenient: tc.lenient})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := tag.GetLastFrame(tc.id); !Equal(got, tc.expected) {
+				t.Errorf("Expected %+v, got %+v", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestUTF16BinaryDataRoundTrip checks that binary data starting with a zero byte after UTF-16 text survives a round trip.
+func TestUTF16BinaryDataRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	picture := PictureFrame{
+		Encoding:    EncodingUTF16,
+		MimeType:    "image/png",
+		PictureType: PTFrontCover,
+		Description: "Cover",
+		Picture:     []byte{0, 1, 2, 3},
+	}
+	object := UnknownFrame{Body: []byte{1, 0xFE, 0xFF, 0, 0, 0xFE, 0xFF, 0, 'f', 0, 0, 0xFE, 0xFF, 0, 0, 0, 1, 2, 3}}
+
+	tag := NewEmptyTag()
+	tag.AddAttachedPicture(picture)
+	tag.AddFrame(string(FrameGEOB), object)
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := ParseBytes(data, Options{Parse: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := parsed.GetLastFrame(tag.CommonID("Attached picture")); !Equal(got, picture) {
+		t.Errorf("Expected %+v, got %+v", picture, got)
+	}
+
+	if got := parsed.GetLastFrame(string(FrameGEOB)); !Equal(got, object) {
+		t.Errorf("Expected %+v, got %+v", object, got)
+	}
+}
+
+// TestParseLegacyUTF16Tag checks that UTF-16 frames written by older versions of the library are parsed correctly.
+func TestParseLegacyUTF16Tag(t *testing.T) {
+	t.Parallel()
+
+	tag, err := Open("testdata/legacy_utf16.id3", Options{Parse: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tag.Close()
+
+	expected := []Framer{
+		TextFrame{Encoding: EncodingUTF16, Text: "Title"},
+		CommentFrame{Encoding: EncodingUTF16, Language: "eng", Text: "Привет"},
+		UnsynchronisedLyricsFrame{Encoding: EncodingUTF16, Language: "eng", Lyrics: "lyrics"},
+		UserDefinedTextFrame{Encoding: EncodingUTF16, Description: "key", Value: "val"},
+	}
+	ids := []string{"Title", "Comments", "Unsynchronised lyrics/text transcription", "User defined text information frame"}
+
+	for i, id := range ids {
+		if got := tag.GetLastFrame(tag.CommonID(id)); !Equal(got, expected[i]) {
+			t.Errorf("Expected %+v, got %+v", expected[i], got)
+		}
+	}
+
+	lenient, err := Open("testdata/legacy_utf16.id3", Options{Parse: true, Lenient: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lenient.Close()
+
+	picture, ok := lenient.GetLastFrame(lenient.CommonID("Attached picture")).(PictureFrame)
+	if !ok || !bytes.Equal(picture.Picture, []byte{0xFF, 0xD8, 0xFF, 0xE0}) || picture.Description != "Cover" {
+		t.Errorf("Expected the picture to be parsed in lenient mode, got %+v", picture)
+	}
+}
@@ -0,0 +1,338 @@
+package id3v2
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ffMetadataHeader is the header every FFMETADATA file starts with.
+	ffMetadataHeader = ";FFMETADATA1"
+
+	// ffMetadataChapterSection is the name of the section describing a chapter.
+	ffMetadataChapterSection = "[CHAPTER]"
+
+	// ffMetadataTimebase is the timebase of chapter times written by WriteFFMetadata (milliseconds).
+	ffMetadataTimebase = "1/1000"
+)
+
+// FFMetadataKeys maps the global keys of ffmpeg's FFMETADATA format to frame descriptions
+// that can be passed to tag.CommonID.
+// User-defined text frames (TXXX) use their descriptions as keys.
+var FFMetadataKeys = map[string]string{
+	"album":        "Album/Movie/Show title",
+	"album_artist": "Band/Orchestra/Accompaniment",
+	"artist":       ArtistFrameDescription,
+	"composer":     "Composer",
+	"copyright":    "Copyright message",
+	"date":         "Year",
+	"disc":         "Part of a set",
+	"encoded_by":   "Encoded by",
+	"encoder":      "Software/Hardware and settings used for encoding",
+	"genre":        "Genre",
+	"grouping":     "Content group description",
+	"language":     "Language",
+	"performer":    "Conductor/performer refinement",
+	"publisher":    "Publisher",
+	"title":        TitleFrameDescription,
+	"track":        "Track number/Position in set",
+}
+
+// ffMetadataCommentKey is the global key for the comment.
+const ffMetadataCommentKey = "comment"
+
+// ffMetadataDefaultTimebase is the timebase of chapter times if the TIMEBASE key is absent (nanoseconds).
+const ffMetadataDefaultTimebase = "1/1000000000"
+
+// ErrInvalidFFMetadata is returned when an FFMETADATA file is malformed.
+var ErrInvalidFFMetadata = errors.New("invalid FFMETADATA file")
+
+// WriteFFMetadata writes the tag in ffmpeg's FFMETADATA format to w,
+// so it can be passed to ffmpeg (e.g., `ffmpeg -i in.mp3 -i meta.txt -map_metadata 1 out.m4a`).
+// Text frames listed in FFMetadataKeys, user-defined text frames and the first comment
+// become global keys, and every chapter frame becomes a [CHAPTER] section
+// with millisecond timebase and its title.
+func (tag *Tag) WriteFFMetadata(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString(ffMetadataHeader + "\n")
+
+	// Collect the global keys and write them in a stable order.
+	global := make(map[string]string)
+
+	for key, description := range FFMetadataKeys {
+		if text := tag.GetTextFrame(tag.CommonID(description)).Text; text != "" {
+			global[key] = text
+		}
+	}
+
+	for _, f := range tag.GetFrames(tag.CommonID("User defined text information frame")) {
+		if udtf, ok := f.(UserDefinedTextFrame); ok && udtf.Description != "" {
+			global[udtf.Description] = udtf.Value
+		}
+	}
+
+	if comments := tag.GetFrames(tag.CommonID("Comments")); len(comments) > 0 {
+		if cf, ok := comments[0].(CommentFrame); ok {
+			global[ffMetadataCommentKey] = cf.Text
+		}
+	}
+
+	keys := make([]string, 0, len(global))
+	for key := range global {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		bw.WriteString(escapeFFMetadata(key) + "=" + escapeFFMetadata(global[key]) + "\n")
+	}
+
+	// Write the chapters ordered by their start time.
+	for _, chapter := range tag.Chapters() {
+		bw.WriteString(ffMetadataChapterSection + "\n")
+		bw.WriteString("TIMEBASE=" + ffMetadataTimebase + "\n")
+		bw.WriteString("START=" + strconv.FormatInt(chapter.StartTime.Milliseconds(), 10) + "\n")
+		bw.WriteString("END=" + strconv.FormatInt(chapter.EndTime.Milliseconds(), 10) + "\n")
+
+		if chapter.Title != nil && chapter.Title.Text != "" {
+			bw.WriteString("title=" + escapeFFMetadata(chapter.Title.Text) + "\n")
+		}
+	}
+
+	return bw.Flush()
+}
+
+// ReadFFMetadata reads a file in ffmpeg's FFMETADATA format from r and adds its content to the tag.
+// It's the counterpart of WriteFFMetadata: global keys listed in FFMetadataKeys become text frames,
+// the "comment" key becomes a comment frame and other global keys become user-defined text frames.
+// Every [CHAPTER] section becomes a chapter frame with its title,
+// and a top-level table of contents listing the chapters is added.
+// [STREAM] sections are ignored.
+func (tag *Tag) ReadFFMetadata(r io.Reader) error {
+	lines, err := readLinesFromReader(r, func(sourceLine string) (string, bool) {
+		return strings.TrimSuffix(sourceLine, "\r"), false
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(lines) == 0 || lines[0] != ffMetadataHeader {
+		return fmt.Errorf("%w: missing %s header", ErrInvalidFFMetadata, ffMetadataHeader)
+	}
+
+	var (
+		section  string
+		chapters []ffMetadataChapter
+	)
+
+	for i := 1; i < len(lines); i++ {
+		line := lines[i]
+
+		// A trailing unescaped backslash continues the value on the next line.
+		for hasContinuation(line) && i+1 < len(lines) {
+			i++
+			line = line[:len(line)-1] + "\n" + lines[i]
+		}
+
+		switch {
+		case line == "" || line[0] == ';' || line[0] == '#':
+			continue
+		case line[0] == '[':
+			section = strings.ToUpper(line)
+			if section == ffMetadataChapterSection {
+				chapters = append(chapters, ffMetadataChapter{timebase: ffMetadataDefaultTimebase})
+			}
+
+			continue
+		}
+
+		key, value, found := cutFFMetadata(line)
+		if !found {
+			return fmt.Errorf("%w: line %d has no value", ErrInvalidFFMetadata, i+1)
+		}
+
+		switch section {
+		case "":
+			tag.applyFFMetadataKey(key, value)
+		case ffMetadataChapterSection:
+			chapters[len(chapters)-1].set(key, value)
+		}
+	}
+
+	return tag.addFFMetadataChapters(chapters)
+}
+
+// applyFFMetadataKey adds the global FFMETADATA key to the tag.
+func (tag *Tag) applyFFMetadataKey(key, value string) {
+	if description, ok := FFMetadataKeys[strings.ToLower(key)]; ok {
+		tag.AddTextFrame(tag.CommonID(description), tag.DefaultEncoding(), value)
+
+		return
+	}
+
+	if strings.EqualFold(key, ffMetadataCommentKey) {
+		tag.AddCommentFrame(CommentFrame{
+			Encoding: tag.DefaultEncoding(),
+			Language: EnglishISO6392Code,
+			Text:     value,
+		})
+
+		return
+	}
+
+	tag.AddUserDefinedTextFrame(UserDefinedTextFrame{
+		Encoding:    tag.DefaultEncoding(),
+		Description: key,
+		Value:       value,
+	})
+}
+
+// addFFMetadataChapters adds the chapters and a table of contents listing them to the tag.
+func (tag *Tag) addFFMetadataChapters(chapters []ffMetadataChapter) error {
+	if len(chapters) == 0 {
+		return nil
+	}
+
+	tocf := TableOfContentsFrame{
+		ElementID: defaultTableOfContentsElementID,
+		TopLevel:  true,
+		Ordered:   true,
+	}
+
+	for i, chapter := range chapters {
+		start, err := chapter.duration(chapter.start)
+		if err != nil {
+			return err
+		}
+
+		end, err := chapter.duration(chapter.end)
+		if err != nil {
+			return err
+		}
+
+		cf := ChapterFrame{
+			ElementID:   defaultChapterElementIDPrefix + strconv.Itoa(i+1),
+			StartTime:   start,
+			EndTime:     end,
+			StartOffset: IgnoredOffset,
+			EndOffset:   IgnoredOffset,
+		}
+
+		if chapter.title != "" {
+			cf.Title = &TextFrame{Encoding: tag.DefaultEncoding(), Text: chapter.title}
+		}
+
+		tag.AddChapterFrame(cf)
+
+		tocf.ChildElementIDs = append(tocf.ChildElementIDs, cf.ElementID)
+	}
+
+	tag.AddTableOfContentsFrame(tocf)
+
+	return nil
+}
+
+// ffMetadataChapter holds the values of a [CHAPTER] section.
+type ffMetadataChapter struct {
+	timebase string
+	start    string
+	end      string
+	title    string
+}
+
+// set sets the value of a chapter key. Unknown keys are ignored.
+func (chapter *ffMetadataChapter) set(key, value string) {
+	switch strings.ToUpper(key) {
+	case "TIMEBASE":
+		chapter.timebase = value
+	case "START":
+		chapter.start = value
+	case "END":
+		chapter.end = value
+	case "TITLE":
+		chapter.title = value
+	}
+}
+
+// duration converts a chapter time in timebase units to a duration.
+func (chapter ffMetadataChapter) duration(value string) (time.Duration, error) {
+	units, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid chapter time %q", ErrInvalidFFMetadata, value)
+	}
+
+	numerator, denominator, _ := strings.Cut(chapter.timebase, "/")
+
+	num, err := strconv.ParseInt(strings.TrimSpace(numerator), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid timebase %q", ErrInvalidFFMetadata, chapter.timebase)
+	}
+
+	den, err := strconv.ParseInt(strings.TrimSpace(denominator), 10, 64)
+	if err != nil || den <= 0 {
+		return 0, fmt.Errorf("%w: invalid timebase %q", ErrInvalidFFMetadata, chapter.timebase)
+	}
+
+	// Multiply before dividing to keep the precision, the nanoseconds fit for all practical durations.
+	return time.Duration(units * num * int64(time.Second) / den), nil
+}
+
+// hasContinuation reports whether the line ends with an unescaped backslash.
+func hasContinuation(line string) bool {
+	backslashes := len(line) - len(strings.TrimRight(line, "\\"))
+
+	return backslashes%2 == 1
+}
+
+// cutFFMetadata splits the line at the first unescaped '=' and unescapes the key and the value.
+func cutFFMetadata(line string) (string, string, bool) {
+	var (
+		key     strings.Builder
+		value   strings.Builder
+		current = &key
+		found   bool
+		escaped bool
+	)
+
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '=' && !found:
+			current = &value
+			found = true
+		default:
+			current.WriteRune(r)
+		}
+	}
+
+	return key.String(), value.String(), found
+}
+
+// escapeFFMetadata escapes the characters that are special in FFMETADATA files with a backslash.
+func escapeFFMetadata(s string) string {
+	var sb strings.Builder
+
+	for _, r := range s {
+		switch r {
+		case '=', ';', '#', '\\', '\n':
+			sb.WriteByte('\\')
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
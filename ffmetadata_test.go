@@ -0,0 +1,118 @@
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteFFMetadata(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetTitle("Title = 1; #2")
+	tag.SetArtist("Artist")
+	tag.AddUserDefinedTextFrame(UserDefinedTextFrame{Encoding: EncodingUTF8, Description: "MOOD", Value: "Calm"})
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Text: "Line 1\nLine 2"})
+	tag.AddChapterFrame(ChapterFrame{
+		ElementID: "chp2",
+		StartTime: 2 * time.Second,
+		EndTime:   5 * time.Second,
+		Title:     &TextFrame{Encoding: EncodingUTF8, Text: "Second"},
+	})
+	tag.AddChapterFrame(ChapterFrame{
+		ElementID: "chp1",
+		StartTime: 0,
+		EndTime:   2 * time.Second,
+		Title:     &TextFrame{Encoding: EncodingUTF8, Text: "First"},
+	})
+
+	buf := new(bytes.Buffer)
+	if err := tag.WriteFFMetadata(buf); err != nil {
+		t.Fatalf("Error writing FFMETADATA: %v", err)
+	}
+
+	expected := `;FFMETADATA1
+MOOD=Calm
+artist=Artist
+comment=Line 1\
+Line 2
+title=Title \= 1\; \#2
+[CHAPTER]
+TIMEBASE=1/1000
+START=0
+END=2000
+title=First
+[CHAPTER]
+TIMEBASE=1/1000
+START=2000
+END=5000
+title=Second
+`
+
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestReadFFMetadata(t *testing.T) {
+	t.Parallel()
+
+	ffMetadata := `;FFMETADATA1
+title=Title \= 1\; \#2
+artist=Artist
+; a comment
+MOOD=Calm
+comment=Line 1\
+Line 2
+[STREAM]
+title=ignored
+[CHAPTER]
+TIMEBASE=1/1000
+START=0
+END=2000
+title=First
+[CHAPTER]
+START=2000000000
+END=5000000000
+title=Second
+`
+
+	tag := NewEmptyTag()
+	if err := tag.ReadFFMetadata(strings.NewReader(ffMetadata)); err != nil {
+		t.Fatalf("Error reading FFMETADATA: %v", err)
+	}
+
+	if tag.Title() != "Title = 1; #2" || tag.Artist() != "Artist" {
+		t.Errorf("Unexpected title and artist: %q, %q", tag.Title(), tag.Artist())
+	}
+
+	m := tag.ToMap()
+	if got := m["TXXX:MOOD"]; len(got) != 1 || got[0] != "Calm" {
+		t.Errorf("Expected MOOD Calm, got %v", got)
+	}
+
+	if got := m["COMM:"]; len(got) != 1 || got[0] != "Line 1\nLine 2" {
+		t.Errorf("Expected multi-line comment, got %q", got)
+	}
+
+	chapters := tag.Chapters()
+	if len(chapters) != 2 {
+		t.Fatalf("Expected 2 chapters, got %v", len(chapters))
+	}
+
+	if chapters[1].StartTime != 2*time.Second || chapters[1].EndTime != 5*time.Second ||
+		chapters[1].Title.Text != "Second" {
+		t.Errorf("Unexpected second chapter: %+v", chapters[1])
+	}
+
+	if len(tag.GetFrames(tag.CommonID("Table of contents"))) != 1 {
+		t.Error("Expected a table of contents frame")
+	}
+
+	if err := NewEmptyTag().ReadFFMetadata(strings.NewReader("title=No header")); !errors.Is(err, ErrInvalidFFMetadata) {
+		t.Errorf("Expected %v, got %v", ErrInvalidFFMetadata, err)
+	}
+}
@@ -0,0 +1,56 @@
+package id3v2
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"slices"
+)
+
+// Fingerprint returns the SHA-256 hash of a canonical serialization of all frames,
+// so sync tools can detect metadata changes between library copies without comparing whole files.
+// Frames are serialized with ID3v2.4 frame headers and sorted, so the hash doesn't depend on the order
+// in which the frames were added, the padding or the size format of the tag's version.
+// Identical frames in different encodings produce different hashes.
+func (tag *Tag) Fingerprint() [32]byte {
+	hash := sha256.New()
+
+	for _, frame := range tag.canonicalFrames() {
+		hash.Write(frame)
+	}
+
+	var sum [32]byte
+
+	hash.Sum(sum[:0])
+
+	return sum
+}
+
+// canonicalFrames returns the frames serialized with ID3v2.4 frame headers, sorted byte-wise.
+// Characters that can't be represented in the encoding of a frame are substituted,
+// frames that fail to serialize are left out.
+func (tag *Tag) canonicalFrames() [][]byte {
+	var (
+		buf    bytes.Buffer
+		frames [][]byte
+	)
+
+	bw := getBufWriter(&buf)
+	defer putBufWriter(bw)
+
+	// The callback never fails.
+	_ = tag.iterateOverAllFramesInOrder(func(id string, f Framer) error {
+		buf.Reset()
+		bw.Reset(&buf)
+		bw.substitute = true
+
+		if err := writeFrame(bw, id, f, true); err == nil && bw.Flush() == nil {
+			frames = append(frames, bytes.Clone(buf.Bytes()))
+		}
+
+		return nil
+	})
+
+	slices.SortFunc(frames, bytes.Compare)
+
+	return frames
+}
@@ -0,0 +1,34 @@
+package id3v2
+
+import "testing"
+
+func TestFingerprint(t *testing.T) {
+	t.Parallel()
+
+	comment := func(description string) CommentFrame {
+		return CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: description, Text: "Text"}
+	}
+
+	first := NewEmptyTag()
+	first.SetTitle("Title")
+	first.AddCommentFrame(comment("A"))
+	first.AddCommentFrame(comment("B"))
+
+	second := NewEmptyTag()
+	second.SetVersion(3)
+	second.SetDefaultEncoding(EncodingUTF8)
+	second.SetWriteOptions(WriteOptions{Padding: 1024})
+	second.AddCommentFrame(comment("B"))
+	second.AddCommentFrame(comment("A"))
+	second.SetTitle("Title")
+
+	if first.Fingerprint() != second.Fingerprint() {
+		t.Error("Expected equal fingerprints of the same frames added in a different order")
+	}
+
+	second.SetTitle("Other")
+
+	if first.Fingerprint() == second.Fingerprint() {
+		t.Error("Expected different fingerprints after changing a frame")
+	}
+}
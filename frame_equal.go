@@ -0,0 +1,178 @@
+package id3v2
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"slices"
+)
+
+// Equal reports whether the frames have the same type and content, including their encodings,
+// so tests and diff tools don't have to rely on reflect.DeepEqual, which tells apart
+// nil and empty slices or big.Int values with different internals.
+// Frames of the types of this package are compared with their Equals methods,
+// other frames (e.g., registered with RegisterFrameParser) are compared by their written bytes.
+// Streamed pictures are never equal, not even to themselves, as their image data can't be compared
+// without writing it.
+func Equal(a, b Framer) bool {
+	if equal, ok := equalTyped(a, b); ok {
+		return equal
+	}
+
+	aKey, aOK := frameContentKey(a)
+	bKey, bOK := frameContentKey(b)
+
+	if aOK && bOK {
+		return aKey == bKey
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// equalTyped reports whether the frames are equal if a is nil or has a type of this package.
+// Otherwise ok is false.
+func equalTyped(a, b Framer) (equal, ok bool) {
+	if a == nil || b == nil {
+		return a == nil && b == nil, true
+	}
+
+	switch a := a.(type) {
+	case TextFrame:
+		return equalAs(a, b, TextFrame.Equals), true
+	case UserDefinedTextFrame:
+		return equalAs(a, b, UserDefinedTextFrame.Equals), true
+	case CommentFrame:
+		return equalAs(a, b, CommentFrame.Equals), true
+	case LinkFrame:
+		return equalAs(a, b, LinkFrame.Equals), true
+	case PictureFrame:
+		return equalAs(a, b, PictureFrame.Equals), true
+	case PopularimeterFrame:
+		return equalAs(a, b, PopularimeterFrame.Equals), true
+	case UFIDFrame:
+		return equalAs(a, b, UFIDFrame.Equals), true
+	case UnknownFrame:
+		return equalAs(a, b, UnknownFrame.Equals), true
+	case UnsynchronisedLyricsFrame:
+		return equalAs(a, b, UnsynchronisedLyricsFrame.Equals), true
+	case SynchronisedLyricsFrame:
+		return equalAs(a, b, SynchronisedLyricsFrame.Equals), true
+	case ChapterFrame:
+		return equalAs(a, b, ChapterFrame.Equals), true
+	case TableOfContentsFrame:
+		return equalAs(a, b, TableOfContentsFrame.Equals), true
+	case StreamedPictureFrame:
+		return false, true
+	}
+
+	return false, false
+}
+
+// equalAs reports whether b has the type of a and equals it.
+func equalAs[T Framer](a T, b Framer, equals func(T, T) bool) bool {
+	other, ok := b.(T)
+
+	return ok && equals(a, other)
+}
+
+// equalPointers reports whether both pointers are nil or point to equal values.
+func equalPointers[T any](a, b *T, equals func(T, T) bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return equals(*a, *b)
+}
+
+// Equals reports whether the text frames have the same encoding and values.
+// A frame without Multi equals the frame with the single value in Multi, as parsed frames have.
+func (tf TextFrame) Equals(other TextFrame) bool {
+	return tf.Encoding.Equals(other.Encoding) && tf.Text == other.Text &&
+		slices.Equal(tf.Values(), other.Values())
+}
+
+// Equals reports whether the user-defined text frames have the same encoding, description and values.
+func (udtf UserDefinedTextFrame) Equals(other UserDefinedTextFrame) bool {
+	return udtf.Encoding.Equals(other.Encoding) && udtf.Description == other.Description &&
+		slices.Equal(udtf.Values(), other.Values())
+}
+
+// Equals reports whether the comment frames have the same encoding, language, description and text.
+func (cf CommentFrame) Equals(other CommentFrame) bool {
+	return cf.Encoding.Equals(other.Encoding) && cf.Language == other.Language &&
+		cf.Description == other.Description && cf.Text == other.Text
+}
+
+// Equals reports whether the link frames have the same encoding, description and URL.
+func (lf LinkFrame) Equals(other LinkFrame) bool {
+	return lf.Encoding.Equals(other.Encoding) && lf.Description == other.Description && lf.URL == other.URL
+}
+
+// Equals reports whether the picture frames have the same encoding, MIME type, picture type,
+// description and image data.
+func (pf PictureFrame) Equals(other PictureFrame) bool {
+	return pf.Encoding.Equals(other.Encoding) && pf.MimeType == other.MimeType &&
+		pf.PictureType == other.PictureType && pf.Description == other.Description &&
+		bytes.Equal(pf.Picture, other.Picture)
+}
+
+// Equals reports whether the popularimeter frames have the same email, rating and counter.
+// A nil counter equals zero.
+func (pf PopularimeterFrame) Equals(other PopularimeterFrame) bool {
+	return pf.Email == other.Email && pf.Rating == other.Rating &&
+		counterValue(pf.Counter).Cmp(counterValue(other.Counter)) == 0
+}
+
+// counterValue returns the counter, or zero if it's nil.
+func counterValue(counter *big.Int) *big.Int {
+	if counter == nil {
+		return new(big.Int)
+	}
+
+	return counter
+}
+
+// Equals reports whether the unique file identifier frames have the same owner and identifier.
+func (ufid UFIDFrame) Equals(other UFIDFrame) bool {
+	return ufid.OwnerIdentifier == other.OwnerIdentifier && bytes.Equal(ufid.Identifier, other.Identifier)
+}
+
+// Equals reports whether the unknown frames have the same body.
+func (uf UnknownFrame) Equals(other UnknownFrame) bool {
+	return bytes.Equal(uf.Body, other.Body)
+}
+
+// Equals reports whether the unsynchronised lyrics frames have the same encoding, language,
+// content descriptor and lyrics.
+func (uslf UnsynchronisedLyricsFrame) Equals(other UnsynchronisedLyricsFrame) bool {
+	return uslf.Encoding.Equals(other.Encoding) && uslf.Language == other.Language &&
+		uslf.ContentDescriptor == other.ContentDescriptor && uslf.Lyrics == other.Lyrics
+}
+
+// Equals reports whether the synchronised lyrics frames have the same encoding, language, formats,
+// content descriptor and synchronized texts.
+func (sylf SynchronisedLyricsFrame) Equals(other SynchronisedLyricsFrame) bool {
+	return sylf.Encoding.Equals(other.Encoding) && sylf.Language == other.Language &&
+		sylf.TimestampFormat == other.TimestampFormat && sylf.ContentType == other.ContentType &&
+		sylf.ContentDescriptor == other.ContentDescriptor &&
+		slices.Equal(sylf.SynchronizedTexts, other.SynchronizedTexts)
+}
+
+// Equals reports whether the chapter frames have the same element ID, times, offsets and subframes.
+func (cf ChapterFrame) Equals(other ChapterFrame) bool {
+	return cf.ElementID == other.ElementID && cf.StartTime == other.StartTime && cf.EndTime == other.EndTime &&
+		cf.StartOffset == other.StartOffset && cf.EndOffset == other.EndOffset &&
+		equalPointers(cf.Title, other.Title, TextFrame.Equals) &&
+		equalPointers(cf.Description, other.Description, TextFrame.Equals) &&
+		equalPointers(cf.Link, other.Link, LinkFrame.Equals) &&
+		equalPointers(cf.Artwork, other.Artwork, PictureFrame.Equals)
+}
+
+// Equals reports whether the table of contents frames have the same element ID, flags,
+// child element IDs and subframes. Nil and empty child element IDs are equal.
+func (tocf TableOfContentsFrame) Equals(other TableOfContentsFrame) bool {
+	return tocf.ElementID == other.ElementID && tocf.TopLevel == other.TopLevel && tocf.Ordered == other.Ordered &&
+		slices.Equal(tocf.ChildElementIDs, other.ChildElementIDs) &&
+		equalPointers(tocf.Title, other.Title, TextFrame.Equals) &&
+		equalPointers(tocf.Description, other.Description, TextFrame.Equals)
+}
@@ -0,0 +1,76 @@
+package id3v2
+
+import (
+	"io"
+	"math/big"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	title := TextFrame{Encoding: EncodingUTF8, Text: "Title"}
+	streamed := StreamedPictureFrame{Encoding: EncodingUTF8, MimeType: MimeTypePNG, WritePicture: func(io.Writer) error {
+		return nil
+	}}
+
+	testCases := []struct {
+		name  string
+		a, b  Framer
+		equal bool
+	}{
+		{"nil frames", nil, nil, true},
+		{"nil and non-nil frames", title, nil, false},
+		{"parsed text frame", title, TextFrame{Encoding: EncodingUTF8, Text: "Title", Multi: []string{"Title"}}, true},
+		{"text frames in different encodings", title, TextFrame{Encoding: EncodingISO, Text: "Title"}, false},
+		{"text frames with different values", title, TextFrame{Encoding: EncodingUTF8, Text: "Other"}, false},
+		{"different types", title, UserDefinedTextFrame{Encoding: EncodingUTF8, Value: "Title"}, false},
+		{
+			"popularimeter frames with nil and zero counters",
+			PopularimeterFrame{Email: "me", Rating: 1},
+			PopularimeterFrame{Email: "me", Rating: 1, Counter: big.NewInt(0)},
+			true,
+		},
+		{
+			"popularimeter frames with different counters",
+			PopularimeterFrame{Email: "me", Counter: big.NewInt(1)},
+			PopularimeterFrame{Email: "me", Counter: big.NewInt(2)},
+			false,
+		},
+		{
+			"pictures with nil and empty data",
+			PictureFrame{Encoding: EncodingUTF8, MimeType: MimeTypePNG},
+			PictureFrame{Encoding: EncodingUTF8, MimeType: MimeTypePNG, Picture: []byte{}},
+			true,
+		},
+		{
+			"chapters with equal titles",
+			ChapterFrame{ElementID: "ch0", Title: &title},
+			ChapterFrame{ElementID: "ch0", Title: &TextFrame{Encoding: EncodingUTF8, Text: "Title"}},
+			true,
+		},
+		{
+			"chapters with and without titles",
+			ChapterFrame{ElementID: "ch0", Title: &title},
+			ChapterFrame{ElementID: "ch0"},
+			false,
+		},
+		{"streamed pictures", streamed, streamed, false},
+		{"custom frames", testRatingFrame{Owner: "me", Stars: 5}, testRatingFrame{Owner: "me", Stars: 5}, true},
+		{"different custom frames", testRatingFrame{Owner: "me", Stars: 5}, testRatingFrame{Owner: "me", Stars: 4}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Equal(tc.a, tc.b); got != tc.equal {
+				t.Errorf("Expected %v, got %v", tc.equal, got)
+			}
+
+			if got := Equal(tc.b, tc.a); got != tc.equal {
+				t.Errorf("Expected %v for swapped frames, got %v", tc.equal, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package id3v2
+
+import "strings"
+
+// v22FrameIDs maps the 3-character frame IDs of ID3v2.2 to the frame IDs of ID3v2.3,
+// as some writers emit them padded with a space or a zero byte (e.g., "TAL ").
+// Frames whose bodies differ between the versions (e.g., PIC) aren't mapped.
+var v22FrameIDs = map[string]string{
+	"COM": "COMM", "CNT": "PCNT", "POP": "POPM", "UFI": "UFID", "ULT": "USLT",
+	"TAL": "TALB", "TBP": "TBPM", "TCM": "TCOM", "TCO": "TCON", "TCR": "TCOP",
+	"TDA": "TDAT", "TEN": "TENC", "TFT": "TFLT", "TIM": "TIME", "TKE": "TKEY",
+	"TLA": "TLAN", "TLE": "TLEN", "TMT": "TMED", "TOA": "TOPE", "TOF": "TOFN",
+	"TOL": "TOLY", "TOR": "TORY", "TOT": "TOAL", "TP1": "TPE1", "TP2": "TPE2",
+	"TP3": "TPE3", "TP4": "TPE4", "TPA": "TPOS", "TPB": "TPUB", "TRC": "TSRC",
+	"TRD": "TRDA", "TRK": "TRCK", "TSI": "TSIZ", "TSS": "TSSE", "TT1": "TIT1",
+	"TT2": "TIT2", "TT3": "TIT3", "TXT": "TEXT", "TXX": "TXXX", "TYE": "TYER",
+	"WXX": "WXXX",
+}
+
+// normalizeFrameID returns the canonical frame ID for a slightly malformed one:
+// lowercase letters are converted to uppercase (e.g., "Comm" to "COMM"),
+// and ID3v2.2 IDs padded with a space or a zero byte are mapped to ID3v2.3 IDs (e.g., "TAL " to "TALB").
+// It returns false if the ID can't be normalized.
+func normalizeFrameID(id string) (string, bool) {
+	upper := strings.ToUpper(id)
+
+	if trimmed := strings.TrimRight(upper, " \x00"); len(trimmed) == 3 && len(upper) == 4 {
+		normalized, ok := v22FrameIDs[trimmed]
+
+		return normalized, ok
+	}
+
+	return upper, isValidFrameID(upper)
+}
+
+// isNormalizableFrameID reports whether normalizeFrameID accepts the ID.
+func isNormalizableFrameID(id string) bool {
+	_, ok := normalizeFrameID(id)
+
+	return ok
+}
@@ -0,0 +1,122 @@
+package id3v2
+
+// FrameID is the 4-character identifier of an ID3v2 frame, e.g. "TIT2".
+// The Frame* constants cover all frames defined in ID3v2.3 and ID3v2.4,
+// so misspelled IDs are caught at compile time. They can be passed anywhere
+// a frame ID or a description is expected, including tag.CommonID.
+type FrameID = string
+
+// Frame IDs defined in ID3v2.3 and ID3v2.4.
+const (
+	FrameAENC FrameID = "AENC" // Audio encryption.
+	FrameAPIC FrameID = "APIC" // Attached picture.
+	FrameASPI FrameID = "ASPI" // Audio seek point index, ID3v2.4 only.
+	FrameCHAP FrameID = "CHAP" // Chapter.
+	FrameCOMM FrameID = "COMM" // Comments.
+	FrameCOMR FrameID = "COMR" // Commercial frame.
+	FrameCTOC FrameID = "CTOC" // Table of contents.
+	FrameENCR FrameID = "ENCR" // Encryption method registration.
+	FrameEQU2 FrameID = "EQU2" // Equalisation (2), ID3v2.4 only.
+	FrameEQUA FrameID = "EQUA" // Equalization, ID3v2.3 only.
+	FrameETCO FrameID = "ETCO" // Event timing codes.
+	FrameGEOB FrameID = "GEOB" // General encapsulated object.
+	FrameGRID FrameID = "GRID" // Group identification registration.
+	FrameIPLS FrameID = "IPLS" // Involved people list, ID3v2.3 only.
+	FrameLINK FrameID = "LINK" // Linked information.
+	FrameMCDI FrameID = "MCDI" // Music CD identifier.
+	FrameMLLT FrameID = "MLLT" // MPEG location lookup table.
+	FrameOWNE FrameID = "OWNE" // Ownership frame.
+	FramePCNT FrameID = "PCNT" // Play counter.
+	FramePOPM FrameID = "POPM" // Popularimeter.
+	FramePOSS FrameID = "POSS" // Position synchronisation frame.
+	FramePRIV FrameID = "PRIV" // Private frame.
+	FrameRBUF FrameID = "RBUF" // Recommended buffer size.
+	FrameRVA2 FrameID = "RVA2" // Relative volume adjustment (2), ID3v2.4 only.
+	FrameRVAD FrameID = "RVAD" // Relative volume adjustment, ID3v2.3 only.
+	FrameRVRB FrameID = "RVRB" // Reverb.
+	FrameSEEK FrameID = "SEEK" // Seek frame, ID3v2.4 only.
+	FrameSIGN FrameID = "SIGN" // Signature frame, ID3v2.4 only.
+	FrameSYLT FrameID = "SYLT" // Synchronised lyrics/text.
+	FrameSYTC FrameID = "SYTC" // Synchronised tempo codes.
+	FrameTALB FrameID = "TALB" // Album/Movie/Show title.
+	FrameTBPM FrameID = "TBPM" // BPM (beats per minute).
+	FrameTCOM FrameID = "TCOM" // Composer.
+	FrameTCON FrameID = "TCON" // Content type.
+	FrameTCOP FrameID = "TCOP" // Copyright message.
+	FrameTDAT FrameID = "TDAT" // Date, ID3v2.3 only.
+	FrameTDEN FrameID = "TDEN" // Encoding time, ID3v2.4 only.
+	FrameTDLY FrameID = "TDLY" // Playlist delay.
+	FrameTDOR FrameID = "TDOR" // Original release time, ID3v2.4 only.
+	FrameTDRC FrameID = "TDRC" // Recording time, ID3v2.4 only.
+	FrameTDRL FrameID = "TDRL" // Release time, ID3v2.4 only.
+	FrameTDTG FrameID = "TDTG" // Tagging time, ID3v2.4 only.
+	FrameTENC FrameID = "TENC" // Encoded by.
+	FrameTEXT FrameID = "TEXT" // Lyricist/Text writer.
+	FrameTFLT FrameID = "TFLT" // File type.
+	FrameTIME FrameID = "TIME" // Time, ID3v2.3 only.
+	FrameTIPL FrameID = "TIPL" // Involved people list, ID3v2.4 only.
+	FrameTIT1 FrameID = "TIT1" // Content group description.
+	FrameTIT2 FrameID = "TIT2" // Title/Songname/Content description.
+	FrameTIT3 FrameID = "TIT3" // Subtitle/Description refinement.
+	FrameTKEY FrameID = "TKEY" // Initial key.
+	FrameTLAN FrameID = "TLAN" // Language(s).
+	FrameTLEN FrameID = "TLEN" // Length.
+	FrameTMCL FrameID = "TMCL" // Musician credits list, ID3v2.4 only.
+	FrameTMED FrameID = "TMED" // Media type.
+	FrameTMOO FrameID = "TMOO" // Mood, ID3v2.4 only.
+	FrameTOAL FrameID = "TOAL" // Original album/movie/show title.
+	FrameTOFN FrameID = "TOFN" // Original filename.
+	FrameTOLY FrameID = "TOLY" // Original lyricist(s)/text writer(s).
+	FrameTOPE FrameID = "TOPE" // Original artist(s)/performer(s).
+	FrameTORY FrameID = "TORY" // Original release year, ID3v2.3 only.
+	FrameTOWN FrameID = "TOWN" // File owner/licensee.
+	FrameTPE1 FrameID = "TPE1" // Lead performer(s)/Soloist(s).
+	FrameTPE2 FrameID = "TPE2" // Band/orchestra/accompaniment.
+	FrameTPE3 FrameID = "TPE3" // Conductor/performer refinement.
+	FrameTPE4 FrameID = "TPE4" // Interpreted, remixed, or otherwise modified by.
+	FrameTPOS FrameID = "TPOS" // Part of a set.
+	FrameTPRO FrameID = "TPRO" // Produced notice, ID3v2.4 only.
+	FrameTPUB FrameID = "TPUB" // Publisher.
+	FrameTRCK FrameID = "TRCK" // Track number/Position in set.
+	FrameTRDA FrameID = "TRDA" // Recording dates, ID3v2.3 only.
+	FrameTRSN FrameID = "TRSN" // Internet radio station name.
+	FrameTRSO FrameID = "TRSO" // Internet radio station owner.
+	FrameTSIZ FrameID = "TSIZ" // Size, ID3v2.3 only.
+	FrameTSOA FrameID = "TSOA" // Album sort order, ID3v2.4 only.
+	FrameTSOP FrameID = "TSOP" // Performer sort order, ID3v2.4 only.
+	FrameTSOT FrameID = "TSOT" // Title sort order, ID3v2.4 only.
+	FrameTSRC FrameID = "TSRC" // ISRC (international standard recording code).
+	FrameTSSE FrameID = "TSSE" // Software/Hardware and settings used for encoding.
+	FrameTSST FrameID = "TSST" // Set subtitle, ID3v2.4 only.
+	FrameTXXX FrameID = "TXXX" // User defined text information frame.
+	FrameTYER FrameID = "TYER" // Year, ID3v2.3 only.
+	FrameUFID FrameID = "UFID" // Unique file identifier.
+	FrameUSER FrameID = "USER" // Terms of use.
+	FrameUSLT FrameID = "USLT" // Unsynchronised lyric/text transcription.
+	FrameWCOM FrameID = "WCOM" // Commercial information.
+	FrameWCOP FrameID = "WCOP" // Copyright/Legal information.
+	FrameWOAF FrameID = "WOAF" // Official audio file webpage.
+	FrameWOAR FrameID = "WOAR" // Official artist/performer webpage.
+	FrameWOAS FrameID = "WOAS" // Official audio source webpage.
+	FrameWORS FrameID = "WORS" // Official Internet radio station homepage.
+	FrameWPAY FrameID = "WPAY" // Payment.
+	FrameWPUB FrameID = "WPUB" // Publishers official webpage.
+	FrameWXXX FrameID = "WXXX" // User defined URL link frame.
+)
+
+var (
+	// v23FrameIDConversions maps ID3v2.4 frame IDs to their ID3v2.3 equivalents.
+	v23FrameIDConversions = map[FrameID]FrameID{
+		FrameTDOR: FrameTORY,
+		FrameTDRC: FrameTYER,
+	}
+
+	// v24FrameIDConversions maps ID3v2.3 frame IDs to their ID3v2.4 equivalents.
+	v24FrameIDConversions = map[FrameID]FrameID{
+		FrameTDAT: FrameTDRC,
+		FrameTIME: FrameTDRC,
+		FrameTORY: FrameTDOR,
+		FrameTRDA: FrameTDRC,
+		FrameTYER: FrameTDRC,
+	}
+)
@@ -0,0 +1,68 @@
+package id3v2
+
+import "slices"
+
+var (
+	// convenienceDescriptions are the shortcut descriptions in V23CommonIDs and V24CommonIDs,
+	// e.g. "Artist" for "Lead artist/Lead performer/Soloist/Performing group".
+	convenienceDescriptions = []string{ArtistFrameDescription, "Genre", TitleFrameDescription}
+
+	// deprecatedV24Descriptions are the descriptions of ID3v2.3 frames in V24CommonIDs
+	// mapped to their ID3v2.4 equivalents.
+	deprecatedV24Descriptions = []string{"Date", "Original release year", "Recording dates", "Size", "Time", "Year"}
+)
+
+// FrameInfo describes a frame ID, e.g. to render a human-readable tag viewer.
+type FrameInfo struct {
+	ID          string // The frame ID.
+	Description string // The description of the frame in the tag's version, see tag.Description.
+	ValidV23    bool   // Whether the frame ID may be used in ID3v2.3.
+	ValidV24    bool   // Whether the frame ID may be used in ID3v2.4.
+	Sequence    bool   // Whether the tag can hold several frames with the ID (e.g., pictures or comments).
+}
+
+// Description returns the description of the frame ID in the tag's version,
+// the reverse of CommonID. For example, passing "TPE1" returns "Lead artist/Lead performer/Soloist/Performing group".
+// Shortcut descriptions like "Artist" are never returned.
+// If the frame ID isn't found, it returns the frame ID itself.
+// All descriptions can be found in the common_ids.go.
+func (tag *Tag) Description(id string) string {
+	ids, skipped := V24CommonIDs, append(slices.Clone(convenienceDescriptions), deprecatedV24Descriptions...)
+	if tag.version == 3 {
+		ids, skipped = V23CommonIDs, convenienceDescriptions
+	}
+
+	var found string
+
+	for description, commonID := range ids {
+		if commonID != id || slices.Contains(skipped, description) {
+			continue
+		}
+
+		// Pick the smallest description to be deterministic.
+		if found == "" || description < found {
+			found = description
+		}
+	}
+
+	if found == "" {
+		return id
+	}
+
+	return found
+}
+
+// FrameInfo returns the description of the frame ID in the tag's version,
+// the versions the ID may be used in and whether it's stored in a sequence.
+// Well-formed IDs that aren't defined by the standard (e.g., experimental frames) are valid in both versions.
+func (tag *Tag) FrameInfo(id string) FrameInfo {
+	valid := isValidFrameID(id)
+
+	return FrameInfo{
+		ID:          id,
+		Description: tag.Description(id),
+		ValidV23:    valid && !slices.Contains(v24OnlyFrameIDs, id),
+		ValidV24:    valid && !slices.Contains(v23OnlyFrameIDs, id),
+		Sequence:    mustFrameBeInSequence(id),
+	}
+}
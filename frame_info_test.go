@@ -0,0 +1,60 @@
+package id3v2
+
+import "testing"
+
+func TestDescription(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		version  byte
+		id       string
+		expected string
+	}{
+		{4, FrameTPE1, "Lead artist/Lead performer/Soloist/Performing group"},
+		{4, FrameTIT2, "Title/Songname/Content description"},
+		{4, FrameTCON, "Content type"},
+		{4, FrameTDRC, "Recording time"},
+		{3, FrameTYER, "Year"},
+		{3, FrameTDAT, "Date"},
+		{4, "XYZW", "XYZW"},
+	}
+
+	for _, tt := range tests {
+		tag := NewEmptyTag()
+		tag.SetVersion(tt.version)
+
+		if got := tag.Description(tt.id); got != tt.expected {
+			t.Errorf("Expected %q for %v in ID3v2.%d, got %q", tt.expected, tt.id, tt.version, got)
+		}
+
+		if got := tag.CommonID(tag.Description(tt.id)); got != tt.id {
+			t.Errorf("Expected CommonID to return %v, got %v", tt.id, got)
+		}
+	}
+}
+
+func TestFrameInfo(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+
+	info := tag.FrameInfo(FrameAPIC)
+	if info.Description != "Attached picture" || !info.ValidV23 || !info.ValidV24 || !info.Sequence {
+		t.Errorf("Unexpected info for APIC: %+v", info)
+	}
+
+	info = tag.FrameInfo(FrameTYER)
+	if !info.ValidV23 || info.ValidV24 || info.Sequence {
+		t.Errorf("Unexpected info for TYER: %+v", info)
+	}
+
+	info = tag.FrameInfo(FrameTDRC)
+	if info.ValidV23 || !info.ValidV24 {
+		t.Errorf("Unexpected info for TDRC: %+v", info)
+	}
+
+	info = tag.FrameInfo("bad")
+	if info.ValidV23 || info.ValidV24 {
+		t.Errorf("Unexpected info for an invalid ID: %+v", info)
+	}
+}
@@ -0,0 +1,35 @@
+package id3v2
+
+// FrameObserver is called with the ID and the frame affected by a change of a tag.
+type FrameObserver func(id string, frame Framer)
+
+// OnFrameAdded registers an observer called after a frame is added to the tag,
+// so caching layers and UIs can react to changes without comparing whole tags.
+// Replacing a frame with a different one (e.g., SetTitle with a new title) is reported
+// as the deletion of the old frame followed by the addition of the new one,
+// replacing a frame with an identical one isn't reported. Frames parsed into the tag
+// and frames changed by methods like FixPictureMimeTypes or Repair are reported as well.
+// Observers are called in the order of registration and must not modify the tag.
+// The observers of a tag passed to the callback of Walk are dropped when the callback returns.
+func (tag *Tag) OnFrameAdded(observer FrameObserver) {
+	tag.frameAddedObservers = append(tag.frameAddedObservers, observer)
+}
+
+// OnFrameDeleted registers an observer called after a frame is removed from the tag, see OnFrameAdded.
+func (tag *Tag) OnFrameDeleted(observer FrameObserver) {
+	tag.frameDeletedObservers = append(tag.frameDeletedObservers, observer)
+}
+
+// notifyFrameAdded calls the observers registered with OnFrameAdded.
+func (tag *Tag) notifyFrameAdded(id string, frame Framer) {
+	for _, observer := range tag.frameAddedObservers {
+		observer(id, frame)
+	}
+}
+
+// notifyFrameDeleted calls the observers registered with OnFrameDeleted.
+func (tag *Tag) notifyFrameDeleted(id string, frame Framer) {
+	for _, observer := range tag.frameDeletedObservers {
+		observer(id, frame)
+	}
+}
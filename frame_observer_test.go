@@ -0,0 +1,82 @@
+package id3v2
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFrameObservers(t *testing.T) {
+	t.Parallel()
+
+	var events []string
+
+	tag := NewEmptyTag()
+	tag.OnFrameAdded(func(id string, frame Framer) {
+		events = append(events, fmt.Sprintf("added %s %v", id, frameTexts(frame)))
+	})
+	tag.OnFrameDeleted(func(id string, frame Framer) {
+		events = append(events, fmt.Sprintf("deleted %s %v", id, frameTexts(frame)))
+	})
+
+	tag.SetTitle("Title")
+	tag.SetTitle("Title")
+	tag.SetTitle("Other")
+	tag.SetArtist("Artist")
+	tag.DeleteFrames("TIT2")
+	tag.DeleteAllFrames()
+
+	expected := []string{
+		"added TIT2 [Title]",
+		"deleted TIT2 [Title]",
+		"added TIT2 [Other]",
+		"added TPE1 [Artist]",
+		"deleted TIT2 [Other]",
+		"deleted TPE1 [Artist]",
+	}
+
+	if fmt.Sprint(events) != fmt.Sprint(expected) {
+		t.Errorf("Expected %q, got %q", expected, events)
+	}
+}
+
+// TestFrameObserversInWalk checks that observers registered by the callback of Walk
+// aren't called after the callback returns, e.g. for other files processed concurrently.
+func TestFrameObserversInWalk(t *testing.T) {
+	root := t.TempDir()
+
+	for i := range 8 {
+		copyTestFile(t, filepath.Join(root, fmt.Sprintf("%d.mp3", i)))
+	}
+
+	var stray atomic.Int32
+
+	_, err := Walk(root, 4, func(_ string, tag *Tag) error {
+		if len(tag.frameAddedObservers) > 0 || len(tag.frameDeletedObservers) > 0 {
+			t.Error("Expected no observers registered for another file")
+		}
+
+		var done atomic.Bool
+
+		observer := func(string, Framer) {
+			if done.Load() {
+				stray.Add(1)
+			}
+		}
+
+		tag.OnFrameAdded(observer)
+		tag.OnFrameDeleted(observer)
+		tag.SetTitle("Walked")
+		done.Store(true)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stray.Load() != 0 {
+		t.Errorf("Expected no calls after the callback returned, got %v", stray.Load())
+	}
+}
@@ -0,0 +1,88 @@
+package id3v2
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrInvalidFrameParser is returned when a frame parser is registered with an invalid frame ID or a nil function.
+var ErrInvalidFrameParser = errors.New("invalid frame parser")
+
+// FrameReader reads the body of a frame in parsers registered with RegisterFrameParser.
+// The reader is limited to the frame body. Reading methods don't return errors:
+// after the first error they do nothing and return zero values, and Err reports the error,
+// so it's enough to check Err once after reading all fields.
+type FrameReader interface {
+	// Read reads up to len(p) bytes into p.
+	Read(p []byte) (int, error)
+
+	// Byte reads a single byte.
+	Byte() byte
+
+	// Next reads the next n bytes. The returned slice is only valid until the next read.
+	Next(n int) []byte
+
+	// ReadText reads raw text until the termination bytes of the encoding and discards them.
+	ReadText(encoding Encoding) []byte
+
+	// ReadString reads text like ReadText and decodes it to a UTF-8 string.
+	ReadString(encoding Encoding) string
+
+	// ReadAll reads the rest of the frame body.
+	ReadAll() []byte
+
+	// Discard skips the next n bytes.
+	Discard(n int)
+
+	// Err returns the first error encountered during reading.
+	Err() error
+}
+
+// FrameParser parses the body of a frame, version is the ID3v2 version of the tag (3 or 4).
+type FrameParser func(fr FrameReader, version byte) (Framer, error)
+
+var (
+	// customParsers holds the parsers registered with RegisterFrameParser.
+	customParsers = map[string]FrameParser{}
+
+	// customParsersMu guards customParsers.
+	customParsersMu sync.RWMutex
+)
+
+// RegisterFrameParser registers the parser for frames with the ID, so applications can add
+// typed support for proprietary frames (e.g., "XRVA" or "PRIV") instead of getting UnknownFrame.
+// The registered parser takes precedence over the built-in one, including text frames.
+// Frames returned by the parser are added to the tag with AddFrame, so their UniqueIdentifier
+// decides whether they replace each other. It's safe for concurrent use.
+// It returns ErrInvalidFrameParser if the ID isn't a well-formed frame ID or fn is nil.
+func RegisterFrameParser(id string, fn FrameParser) error {
+	if !isValidFrameID(id) || fn == nil {
+		return ErrInvalidFrameParser
+	}
+
+	customParsersMu.Lock()
+	defer customParsersMu.Unlock()
+
+	customParsers[id] = fn
+
+	return nil
+}
+
+// UnregisterFrameParser removes the parser registered with RegisterFrameParser,
+// restoring the built-in behavior for the ID.
+func UnregisterFrameParser(id string) {
+	customParsersMu.Lock()
+	defer customParsersMu.Unlock()
+
+	delete(customParsers, id)
+}
+
+// customParser returns the parser registered for the ID, if any.
+func customParser(id string) (FrameParser, bool) {
+	customParsersMu.RLock()
+	defer customParsersMu.RUnlock()
+
+	fn, ok := customParsers[id]
+
+	return fn, ok
+}
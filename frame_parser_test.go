@@ -0,0 +1,65 @@
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+// testRatingFrame is a proprietary frame used to test RegisterFrameParser.
+type testRatingFrame struct {
+	Owner string
+	Stars byte
+}
+
+func (trf testRatingFrame) Size() int {
+	return len(trf.Owner) + 2
+}
+
+func (trf testRatingFrame) UniqueIdentifier() string {
+	return trf.Owner
+}
+
+func (trf testRatingFrame) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(append([]byte(trf.Owner+"\x00"), trf.Stars))
+
+	return int64(n), err
+}
+
+func TestRegisterFrameParser(t *testing.T) {
+	t.Parallel()
+
+	err := RegisterFrameParser("XRTG", func(fr FrameReader, _ byte) (Framer, error) {
+		frame := testRatingFrame{Owner: fr.ReadString(EncodingISO), Stars: fr.Byte()}
+
+		return frame, fr.Err()
+	})
+	if err != nil {
+		t.Fatalf("Error registering parser: %v", err)
+	}
+
+	defer UnregisterFrameParser("XRTG")
+
+	source := NewEmptyTag()
+	source.AddFrame("XRTG", testRatingFrame{Owner: "me", Stars: 5})
+
+	data, err := source.Bytes()
+	if err != nil {
+		t.Fatalf("Error serializing tag: %v", err)
+	}
+
+	tag, err := ParseReader(bytes.NewReader(data), Options{Parse: true})
+	if err != nil {
+		t.Fatalf("Error parsing tag: %v", err)
+	}
+
+	frame, ok := tag.GetLastFrame("XRTG").(testRatingFrame)
+	if !ok || frame.Owner != "me" || frame.Stars != 5 {
+		t.Errorf("Expected the proprietary frame to be parsed, got %#v", tag.GetLastFrame("XRTG"))
+	}
+
+	if err = RegisterFrameParser("bad", nil); !errors.Is(err, ErrInvalidFrameParser) {
+		t.Errorf("Expected %v, got %v", ErrInvalidFrameParser, err)
+	}
+}
@@ -0,0 +1,133 @@
+package id3v2
+
+import (
+	"fmt"
+	"maps"
+	"sync"
+)
+
+// FrameSerializer transforms a frame before it's written by WriteTo, e.g. to rewrite PRIV payloads.
+// It returns the frames written in place of the frame: nil drops the frame,
+// several frames add more frames with the same ID (e.g., a checksum TXXX frame).
+// If the tag has no frames with the registered ID, the serializer is called once with a nil frame,
+// so it can inject frames.
+type FrameSerializer func(id string, frame Framer) ([]Framer, error)
+
+var (
+	// customSerializers holds the serializers registered with RegisterFrameSerializer.
+	customSerializers = map[string]FrameSerializer{}
+
+	// customSerializersMu guards customSerializers.
+	customSerializersMu sync.RWMutex
+)
+
+// RegisterFrameSerializer registers the serializer invoked by WriteTo for frames with the ID,
+// complementing RegisterFrameParser for plugin-style extensions.
+// The frames stored in the tag aren't changed, only the written tag is. It's safe for concurrent use.
+// It returns ErrInvalidFrameParser if the ID isn't a well-formed frame ID or fn is nil.
+func RegisterFrameSerializer(id string, fn FrameSerializer) error {
+	if !isValidFrameID(id) || fn == nil {
+		return ErrInvalidFrameParser
+	}
+
+	customSerializersMu.Lock()
+	defer customSerializersMu.Unlock()
+
+	customSerializers[id] = fn
+
+	return nil
+}
+
+// UnregisterFrameSerializer removes the serializer registered with RegisterFrameSerializer.
+func UnregisterFrameSerializer(id string) {
+	customSerializersMu.Lock()
+	defer customSerializersMu.Unlock()
+
+	delete(customSerializers, id)
+}
+
+// serialized returns the tag as it should be written: the tag itself if no serializers are registered
+// and no write options change the frames, or a copy with the frames normalized,
+// transformed by the registered serializers and complemented with compatibility frames.
+func (tag *Tag) serialized() (*Tag, error) {
+	customSerializersMu.RLock()
+	serializers := maps.Clone(customSerializers)
+	customSerializersMu.RUnlock()
+
+	unicodeNormalizer := tag.writeOptions.UnicodeNormalization.normalizer()
+
+	if len(serializers) == 0 && len(tag.textNormalizers) == 0 && unicodeNormalizer == nil &&
+		!tag.writeOptions.CompatibilityFrames {
+		return tag, nil
+	}
+
+	out := tag.writeCopy()
+
+	transform := func(id string, f Framer) error {
+		if f != nil && unicodeNormalizer != nil {
+			f = mapFrameTexts(f, unicodeNormalizer)
+		}
+
+		if f != nil && len(tag.textNormalizers) > 0 {
+			var keep bool
+			if f, keep = tag.normalizeTextFrame(f); !keep {
+				return nil
+			}
+		}
+
+		fn, ok := serializers[id]
+		if !ok {
+			out.AddFrame(id, f)
+
+			return nil
+		}
+
+		frames, err := fn(id, f)
+		if err != nil {
+			return fmt.Errorf("serializing frame %s: %w", id, err)
+		}
+
+		for _, frame := range frames {
+			out.AddFrame(id, frame)
+		}
+
+		return nil
+	}
+
+	if err := tag.iterateOverAllFramesInOrder(transform); err != nil {
+		return nil, err
+	}
+
+	// Give the serializers of absent frames a chance to inject them.
+	for id := range serializers {
+		if len(tag.GetFrames(id)) > 0 {
+			continue
+		}
+
+		if err := transform(id, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if tag.writeOptions.CompatibilityFrames {
+		out.addCompatibilityFrames()
+	}
+
+	return out, nil
+}
+
+// writeCopy returns a tag without frames with the settings of the tag that affect writing.
+func (tag *Tag) writeCopy() *Tag {
+	return &Tag{
+		frames:          make(map[string]Framer),
+		sequences:       make(map[string]*sequence),
+		defaultEncoding: tag.defaultEncoding,
+		version:         tag.version,
+		header:          tag.header,
+		headerFlags:     tag.headerFlags,
+		writeOptions:    tag.writeOptions,
+		textNormalizers: tag.textNormalizers,
+		commonIDAliases: tag.commonIDAliases,
+		sequenceKeys:    tag.sequenceKeys,
+	}
+}
@@ -0,0 +1,59 @@
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// TestRegisterFrameSerializer isn't parallel, as the serializer injects frames into every written tag.
+func TestRegisterFrameSerializer(t *testing.T) {
+	err := RegisterFrameSerializer("XPRV", func(_ string, frame Framer) ([]Framer, error) {
+		if frame == nil {
+			return []Framer{UnknownFrame{Body: []byte("injected")}}, nil
+		}
+
+		return nil, nil // Drop the existing frames.
+	})
+	if err != nil {
+		t.Fatalf("Error registering serializer: %v", err)
+	}
+
+	defer UnregisterFrameSerializer("XPRV")
+
+	tag := NewEmptyTag()
+	tag.SetTitle("Title")
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatalf("Error serializing tag: %v", err)
+	}
+
+	parsed, err := ParseReader(bytes.NewReader(data), Options{Parse: true})
+	if err != nil {
+		t.Fatalf("Error parsing tag: %v", err)
+	}
+
+	frame, ok := parsed.GetLastFrame("XPRV").(UnknownFrame)
+	if !ok || string(frame.Body) != "injected" {
+		t.Errorf("Expected the injected frame, got %#v", parsed.GetLastFrame("XPRV"))
+	}
+
+	if len(tag.GetFrames("XPRV")) != 0 {
+		t.Error("Expected the tag itself to be unchanged")
+	}
+
+	tag.AddFrame("XPRV", UnknownFrame{Body: []byte("secret")})
+
+	if data, err = tag.Bytes(); err != nil {
+		t.Fatalf("Error serializing tag: %v", err)
+	}
+
+	if bytes.Contains(data, []byte("secret")) || bytes.Contains(data, []byte("XPRV")) {
+		t.Error("Expected the existing frame to be dropped")
+	}
+
+	if err = RegisterFrameSerializer("XPRV", nil); !errors.Is(err, ErrInvalidFrameParser) {
+		t.Errorf("Expected %v, got %v", ErrInvalidFrameParser, err)
+	}
+}
@@ -0,0 +1,26 @@
+package id3v2
+
+// FrameStats describes the frames with the same ID in a tag, see tag.Stats.
+type FrameStats struct {
+	Count int // The number of frames.
+	Bytes int // The total size of the frames in bytes, including the frame headers.
+}
+
+// Stats returns the number and the total size of the frames per frame ID,
+// so tools can find out why a tag is large (usually several embedded pictures).
+// The sizes are those of the frames as written, without the tag header and the padding.
+func (tag *Tag) Stats() map[string]FrameStats {
+	stats := make(map[string]FrameStats)
+
+	// The callback never fails.
+	_ = tag.iterateOverAllFrames(func(id string, f Framer) error {
+		s := stats[id]
+		s.Count++
+		s.Bytes += frameHeaderSize + f.Size()
+		stats[id] = s
+
+		return nil
+	})
+
+	return stats
+}
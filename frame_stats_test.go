@@ -0,0 +1,34 @@
+package id3v2
+
+import "testing"
+
+func TestStats(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetTitle("Title")
+
+	for _, pictureType := range []byte{PTFrontCover, PTBackCover} {
+		tag.AddAttachedPicture(PictureFrame{
+			Encoding:    EncodingUTF8,
+			MimeType:    MimeTypeJPEG,
+			PictureType: pictureType,
+			Picture:     make([]byte, 1000),
+		})
+	}
+
+	stats := tag.Stats()
+
+	if s := stats["TIT2"]; s.Count != 1 || s.Bytes != frameHeaderSize+tag.GetLastFrame("TIT2").Size() {
+		t.Errorf("Unexpected stats of TIT2: %+v", s)
+	}
+
+	s := stats["APIC"]
+	if s.Count != 2 || s.Bytes <= 2000 {
+		t.Errorf("Unexpected stats of APIC: %+v", s)
+	}
+
+	if total := stats["TIT2"].Bytes + s.Bytes + tagHeaderSize; total != tag.Size() {
+		t.Errorf("Expected total size %d, got %d", tag.Size(), total)
+	}
+}
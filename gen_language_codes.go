@@ -0,0 +1,81 @@
+//go:build ignore
+
+// This program generates language_codes.go from the ISO 639-2 code list
+// published by the Library of Congress. Run it with go generate.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+)
+
+const (
+	codeListURL = "https://www.loc.gov/standards/iso639-2/ISO-639-2_utf-8.txt"
+	outputFile  = "language_codes.go"
+)
+
+func main() {
+	resp, err := http.Get(codeListURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("unexpected status: %s", resp.Status)
+	}
+
+	// Every line has the format "bibliographic|terminologic|alpha-2|English name|French name".
+	var codes []string
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		fields := strings.Split(strings.TrimPrefix(scanner.Text(), "\uFEFF"), "|")
+		if len(fields) < 2 {
+			continue
+		}
+
+		for _, code := range fields[:2] {
+			// Skip empty terminologic codes and the "qaa-qtz" range reserved for local use.
+			if len(code) == 3 {
+				codes = append(codes, code)
+			}
+		}
+	}
+
+	if err = scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	slices.Sort(codes)
+	codes = slices.Compact(codes)
+
+	buf := new(bytes.Buffer)
+	buf.WriteString("// Code generated by gen_language_codes.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package id3v2\n\n")
+	buf.WriteString("// iso6392Codes contains all ISO 639-2 language codes, both bibliographic (B) and terminologic (T).\n")
+	buf.WriteString("// Codes reserved for local use (qaa-qtz) are not listed.\n")
+	buf.WriteString("var iso6392Codes = map[string]struct{}{\n")
+
+	for _, code := range codes {
+		fmt.Fprintf(buf, "\t%q: {},\n", code)
+	}
+
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err = os.WriteFile(outputFile, src, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
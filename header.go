@@ -16,15 +16,97 @@ var (
 	// id3Identifier is the magic number that identifies an ID3v2 tag.
 	id3Identifier = []byte("ID3")
 
+	// id3FooterIdentifier is the magic number that identifies an ID3v2.4 tag footer.
+	id3FooterIdentifier = []byte("3DI")
+
 	// ErrNoTag is returned when the file does not contain an ID3v2 tag.
 	ErrNoTag = errors.New("there is no tag in file")
 )
 
+// Flags of the ID3v2 tag header.
+// See https://id3.org/id3v2.4.0-structure, section 3.1.
+const (
+	HeaderFlagUnsynchronisation = 0b1000_0000 // Unsynchronisation is applied on all frames.
+	HeaderFlagExtendedHeader    = 0b0100_0000 // The header is followed by an extended header.
+	HeaderFlagExperimental      = 0b0010_0000 // The tag is in an experimental stage.
+	HeaderFlagFooter            = 0b0001_0000 // A footer is present at the very end of the tag (ID3v2.4 only).
+)
+
+// tagFooterSize is the size of an ID3v2.4 tag footer in bytes.
+const tagFooterSize = 10
+
 // tagHeader represents the header of an ID3v2 tag.
-// It contains the size of the frames and the version of the ID3v2 tag.
+// It contains the size of the frames, the version, the revision and the flags of the ID3v2 tag.
 type tagHeader struct {
 	FramesSize int64 // Size of the frames in bytes.
 	Version    byte  // Version of the ID3v2 tag (e.g., 3 for ID3v2.3, 4 for ID3v2.4).
+	Revision   byte  // Revision of the ID3v2 tag, usually 0.
+	Flags      byte  // Raw flags byte of the tag header.
+}
+
+// Info describes an ID3v2 tag header as reported by Detect.
+// It only contains information available in the first bytes of the tag,
+// so it can be obtained without parsing any frames.
+type Info struct {
+	Version           byte  // Version of the ID3v2 tag (e.g., 3 for ID3v2.3, 4 for ID3v2.4).
+	Revision          byte  // Revision of the ID3v2 tag, usually 0.
+	Flags             byte  // Raw flags byte of the tag header.
+	Size              int64 // Declared size of the tag, excluding the header and the footer.
+	Unsynchronisation bool  // Whether unsynchronisation is applied on all frames.
+	ExtendedHeader    bool  // Whether the header is followed by an extended header.
+	Experimental      bool  // Whether the tag is in an experimental stage.
+	Footer            bool  // Whether a footer is present at the end of the tag.
+}
+
+// TotalSize returns the number of bytes the tag occupies in the file,
+// including the header and the footer, if present.
+func (info Info) TotalSize() int64 {
+	size := tagHeaderSize + info.Size
+	if info.Footer {
+		size += tagFooterSize
+	}
+
+	return size
+}
+
+// Detect reads only the tag header from the provided reader and describes it.
+// It's a lightweight alternative to ParseReader for fast library scans:
+// exactly 10 bytes are read, no frames are parsed.
+// If the reader doesn't start with an ID3v2 tag, it returns ErrNoTag.
+func Detect(rd io.Reader) (Info, error) {
+	if rd == nil {
+		return Info{}, errors.New("rd is nil")
+	}
+
+	header, err := parseHeader(rd)
+	if err != nil {
+		return Info{}, err
+	}
+
+	return header.info(), nil
+}
+
+// Header describes the header of the tag as it was read from the file: the version, the revision,
+// the flags and the declared size, so tools can display and act on them.
+// Changes of the tag, e.g. with tag.SetVersion, aren't reflected.
+// For tags that weren't read from a file, only the version is set.
+func (tag *Tag) Header() Info {
+	return tag.header
+}
+
+// info converts the parsed tag header into the public Info structure.
+func (header tagHeader) info() Info {
+	return Info{
+		Version:           header.Version,
+		Revision:          header.Revision,
+		Flags:             header.Flags,
+		Size:              header.FramesSize,
+		Unsynchronisation: header.Flags&HeaderFlagUnsynchronisation != 0,
+		ExtendedHeader:    header.Flags&HeaderFlagExtendedHeader != 0,
+		Experimental:      header.Flags&HeaderFlagExperimental != 0,
+		// The footer is defined only in ID3v2.4.
+		Footer: header.Version >= 4 && header.Flags&HeaderFlagFooter != 0,
+	}
 }
 
 // parseHeader reads and parses the ID3v2 tag header from the provided reader.
@@ -53,8 +135,10 @@ func parseHeader(rd io.Reader) (tagHeader, error) {
 		return header, ErrNoTag
 	}
 
-	// Extract the version of the ID3v2 tag from the header.
+	// Extract the version, the revision and the flags of the ID3v2 tag from the header.
 	header.Version = data[3]
+	header.Revision = data[4]
+	header.Flags = data[5]
 
 	// Parse the size of the frames from the header.
 	// The size is stored in a synchsafe format, which ensures that the most significant bit of each byte is 0.
@@ -0,0 +1,142 @@
+package id3v2
+
+import "bytes"
+
+// HeaderFlags are the flags of the tag header written by WriteTo and Save, see tag.SetHeaderFlags.
+type HeaderFlags struct {
+	// Unsynchronisation determines whether the unsynchronisation scheme is applied,
+	// so no false MPEG frame sync appears in the tag. In ID3v2.3 tags it's applied on the whole tag,
+	// in ID3v2.4 tags on every frame.
+	Unsynchronisation bool
+
+	// Footer determines whether a footer, a copy of the header, is written at the end of the tag,
+	// so the tag can be found when scanning the file backwards. Tags with footers have no padding.
+	// Footers are defined only in ID3v2.4, so the flag is ignored in ID3v2.3 tags.
+	Footer bool
+
+	// Experimental determines whether the tag is marked as being in an experimental stage.
+	Experimental bool
+}
+
+// SetHeaderFlags sets the flags of the tag header written by WriteTo and Save,
+// e.g. to deliberately produce tags with footers or unsynchronisation for device compatibility testing.
+// Parsed tags keep the flags of their headers.
+func (tag *Tag) SetHeaderFlags(flags HeaderFlags) {
+	tag.headerFlags = flags
+}
+
+// HeaderFlags returns the flags of the tag header written by WriteTo and Save, see tag.SetHeaderFlags.
+func (tag *Tag) HeaderFlags() HeaderFlags {
+	return tag.headerFlags
+}
+
+// headerRevisionAndFlags returns the revision and the flags written in the tag header and footer.
+// The revision of the parsed header is kept unless the version was changed,
+// so editing a tag doesn't alter its semantics.
+func (tag *Tag) headerRevisionAndFlags() (revision, flags byte) {
+	if tag.header.Version == tag.version {
+		revision = tag.header.Revision
+	}
+
+	if tag.headerFlags.Unsynchronisation {
+		flags |= HeaderFlagUnsynchronisation
+	}
+
+	if tag.headerFlags.Experimental {
+		flags |= HeaderFlagExperimental
+	}
+
+	if tag.footerSize() > 0 {
+		flags |= HeaderFlagFooter
+	}
+
+	return revision, flags
+}
+
+// footerSize returns the size of the footer written at the end of the tag, 0 if there is none.
+func (tag *Tag) footerSize() int {
+	if tag.headerFlags.Footer && tag.version == 4 {
+		return tagFooterSize
+	}
+
+	return 0
+}
+
+// unsynchronisedArea returns the frames and the padding of the tag with unsynchronisation applied
+// according to the version of the tag.
+func (tag *Tag) unsynchronisedArea(substitute bool) ([]byte, error) {
+	var buf bytes.Buffer
+
+	bw := getBufWriter(&buf)
+	defer putBufWriter(bw)
+
+	bw.substitute = substitute
+
+	err := tag.iterateOverAllFramesInOrder(func(id string, f Framer) error {
+		if tag.version == 4 {
+			return writeUnsynchronisedFrame(bw, id, f)
+		}
+
+		return writeFrame(bw, id, f, false)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = bw.Flush(); err != nil {
+		return nil, err
+	}
+
+	// ID3v2.4 frames are unsynchronised one by one. Padding consists of zero bytes,
+	// so it's appended after unsynchronisation.
+	area := buf.Bytes()
+	if tag.version != 4 {
+		area = unsynchronise(area)
+	}
+
+	return append(area, make([]byte, tag.padding(tagHeaderSize+len(area)))...), nil
+}
+
+// writeUnsynchronisedFrame writes a single ID3v2.4 frame with unsynchronisation applied on its body.
+func writeUnsynchronisedFrame(bw *bufferedWriter, id string, frame Framer) error {
+	var body bytes.Buffer
+
+	fbw := getBufWriter(&body)
+	defer putBufWriter(fbw)
+
+	fbw.substitute = bw.substitute
+
+	if _, err := frame.WriteTo(fbw); err != nil {
+		return err
+	}
+
+	if err := fbw.Flush(); err != nil {
+		return err
+	}
+
+	data := unsynchronise(body.Bytes())
+
+	err := writeFrameHeader(bw, id, truncateIntToUint(len(data)), true, frameFlagUnsynchronisation)
+	if err != nil {
+		return err
+	}
+
+	_, err = bw.Write(data)
+
+	return err
+}
+
+// writeTagFooter writes the ID3v2.4 tag footer, a copy of the header with the identifier reversed.
+func writeTagFooter(bw *bufferedWriter, framesSize uint, version, revision, flags byte) error {
+	_, err := bw.Write(id3FooterIdentifier)
+	if err != nil {
+		return err
+	}
+
+	bw.WriteByte(version)
+	bw.WriteByte(revision)
+	bw.WriteByte(flags)
+	bw.WriteBytesSize(framesSize, true)
+
+	return nil
+}
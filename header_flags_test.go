@@ -0,0 +1,135 @@
+package id3v2
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHeaderFlagsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// The picture has false syncs and ends with 0xFF, so unsynchronisation changes it.
+	picture := []byte{0xFF, 0xFB, 0x90, 0x00, 0xFF, 0x00, 0x12, 0xFF, 0xE0, 0xFF}
+
+	for _, version := range []byte{3, 4} {
+		tag := NewEmptyTag()
+		tag.SetVersion(version)
+		tag.SetDefaultEncoding(EncodingUTF16)
+		tag.SetWriteOptions(WriteOptions{Padding: 16})
+		tag.SetTitle("Title")
+		tag.AddAttachedPicture(PictureFrame{
+			Encoding:    EncodingUTF16,
+			MimeType:    MimeTypeJPEG,
+			PictureType: PTFrontCover,
+			Picture:     picture,
+		})
+
+		flags := HeaderFlags{Unsynchronisation: true, Footer: true, Experimental: true}
+		tag.SetHeaderFlags(flags)
+
+		data, err := tag.Bytes()
+		if err != nil {
+			t.Fatalf("v2.%d: error while writing tag: %v", version, err)
+		}
+
+		if len(data) != tag.Size() {
+			t.Errorf("v2.%d: expected size %d, got %d", version, tag.Size(), len(data))
+		}
+
+		for i := tagHeaderSize; i < len(data)-1; i++ {
+			if data[i] == 0xFF && data[i+1] >= 0xE0 {
+				t.Errorf("v2.%d: false sync at offset %d", version, i)
+			}
+		}
+
+		// The footer is written only in ID3v2.4 tags.
+		expectedFlags := byte(HeaderFlagUnsynchronisation | HeaderFlagExperimental)
+		if version == 4 {
+			expectedFlags |= HeaderFlagFooter
+
+			footer := data[len(data)-tagFooterSize:]
+			if !bytes.Equal(footer[:3], id3FooterIdentifier) || !bytes.Equal(footer[3:], data[3:tagHeaderSize]) {
+				t.Errorf("v2.%d: expected footer matching header %v, got %v", version, data[:tagHeaderSize], footer)
+			}
+		} else {
+			flags.Footer = false
+		}
+
+		if data[5] != expectedFlags {
+			t.Errorf("v2.%d: expected flags %08b, got %08b", version, expectedFlags, data[5])
+		}
+
+		parsed, err := ParseBytes(data, parseOpts)
+		if err != nil {
+			t.Fatalf("v2.%d: error while parsing tag: %v", version, err)
+		}
+
+		if parsed.HeaderFlags() != flags {
+			t.Errorf("v2.%d: expected %+v, got %+v", version, flags, parsed.HeaderFlags())
+		}
+
+		if parsed.Title() != "Title" {
+			t.Errorf("v2.%d: expected title %q, got %q", version, "Title", parsed.Title())
+		}
+
+		pictures := parsed.GetFrames("APIC")
+		if len(pictures) != 1 || !bytes.Equal(pictures[0].(PictureFrame).Picture, picture) {
+			t.Errorf("v2.%d: expected picture %v, got %v", version, picture, pictures)
+		}
+	}
+}
+
+func TestSaveTagWithFooter(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "footer.mp3")
+	copyTestFile(t, path)
+
+	tag, err := Open(path, parseOpts)
+	if err != nil {
+		t.Fatal("Error while opening file:", err)
+	}
+
+	audioSize := fileSize(t, path) - tag.AudioOffset()
+
+	tag.SetHeaderFlags(HeaderFlags{Footer: true})
+
+	if err = tag.Save(); err != nil {
+		t.Fatal("Error while saving tag:", err)
+	}
+
+	tag.Close()
+
+	// Saving again must replace the footer along with the tag.
+	if tag, err = Open(path, parseOpts); err != nil {
+		t.Fatal("Error while reopening file:", err)
+	}
+	defer tag.Close()
+
+	if !tag.Header().Footer {
+		t.Error("Expected the tag to have a footer")
+	}
+
+	tag.SetTitle("Title")
+
+	if err = tag.Save(); err != nil {
+		t.Fatal("Error while saving tag:", err)
+	}
+
+	if size := fileSize(t, path); size != int64(tag.Size())+audioSize {
+		t.Errorf("Expected file size %d, got %d", int64(tag.Size())+audioSize, size)
+	}
+}
+
+func fileSize(t *testing.T, path string) int64 {
+	t.Helper()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return info.Size()
+}
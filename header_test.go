@@ -35,7 +35,7 @@ func TestWriteTagHeader(t *testing.T) {
 	buf := new(bytes.Buffer)
 	bw := newBufferedWriter(buf)
 
-	err := writeTagHeader(bw, 15351, 4)
+	err := writeTagHeader(bw, 15351, 4, 0, 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -71,3 +71,93 @@ func TestIsNotID3(t *testing.T) {
 		t.Fatalf("Expected: %q, got: %q", ErrNoTag, err)
 	}
 }
+
+// TestDetect checks if Detect reports the header details correctly.
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{73, 68, 51, 4, 0, HeaderFlagExperimental | HeaderFlagFooter, 0, 0, 0x77, 0x77}
+
+	info, err := Detect(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.Version != 4 || info.Size != th.FramesSize {
+		t.Errorf("Expected version %v and size %v, got %v and %v", 4, th.FramesSize, info.Version, info.Size)
+	}
+
+	if !info.Experimental || !info.Footer || info.Unsynchronisation || info.ExtendedHeader {
+		t.Errorf("Unexpected flags: %+v", info)
+	}
+
+	if info.TotalSize() != tagHeaderSize+th.FramesSize+tagFooterSize {
+		t.Errorf("Expected total size %v, got %v", tagHeaderSize+th.FramesSize+tagFooterSize, info.TotalSize())
+	}
+
+	_, err = Detect(bytes.NewReader(make([]byte, tagHeaderSize)))
+	if !errors.Is(err, ErrNoTag) {
+		t.Fatalf("Expected: %q, got: %q", ErrNoTag, err)
+	}
+}
+
+func TestTagHeader(t *testing.T) {
+	t.Parallel()
+
+	frames := []byte{'T', 'I', 'T', '2', 0, 0, 0, 6, 0, 0, 3, 'T', 'i', 't', 'l', 'e'}
+	data := concat([]byte{'I', 'D', '3', 3, 1, HeaderFlagExperimental, 0, 0, 0, byte(len(frames))}, frames)
+
+	tag, err := ParseBytes(data, parseOpts)
+	if err != nil {
+		t.Fatal("Error while parsing tag:", err)
+	}
+
+	header := tag.Header()
+	if header.Version != 3 || header.Revision != 1 || header.Size != int64(len(frames)) {
+		t.Errorf("Expected version 3, revision 1 and size %d, got %+v", len(frames), header)
+	}
+
+	if !header.Experimental || header.Unsynchronisation || header.ExtendedHeader || header.Footer {
+		t.Errorf("Unexpected flags: %+v", header)
+	}
+
+	if header = NewEmptyTag().Header(); header != (Info{Version: 4}) {
+		t.Errorf("Expected %+v, got %+v", Info{Version: 4}, header)
+	}
+}
+
+func TestTagHeaderRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	frames := []byte{'T', 'I', 'T', '2', 0, 0, 0, 6, 0, 0, 3, 'T', 'i', 't', 'l', 'e'}
+	flags := byte(HeaderFlagExperimental | HeaderFlagExtendedHeader)
+	data := concat([]byte{'I', 'D', '3', 4, 2, flags, 0, 0, 0, byte(len(frames))}, frames)
+
+	// The extended header flag is set without an extended header, so it must not be kept.
+	tag, err := ParseBytes(data, parseOpts)
+	if err != nil {
+		t.Fatal("Error while parsing tag:", err)
+	}
+
+	tag.SetArtist("Artist")
+
+	written, err := tag.Bytes()
+	if err != nil {
+		t.Fatal("Error while writing tag:", err)
+	}
+
+	if revision, flags := written[4], written[5]; revision != 2 || flags != HeaderFlagExperimental {
+		t.Errorf("Expected revision 2 and flags %08b, got %v and %08b", HeaderFlagExperimental, revision, flags)
+	}
+
+	tag.SetVersion(3)
+
+	if written, err = tag.Bytes(); err != nil {
+		t.Fatal("Error while writing tag:", err)
+	}
+
+	if revision, flags := written[4], written[5]; revision != 0 || flags != HeaderFlagExperimental {
+		t.Errorf("Expected revision 0 and flags %08b after changing the version, got %v and %08b",
+			HeaderFlagExperimental, revision, flags)
+	}
+}
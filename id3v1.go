@@ -0,0 +1,191 @@
+package id3v2
+
+import (
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	// id3v1TagSize is the fixed size of an ID3v1 tag in bytes.
+	id3v1TagSize = 128
+
+	// id3v1Identifier is the identifier at the beginning of every ID3v1 tag.
+	id3v1Identifier = "TAG"
+
+	// Field lengths of an ID3v1.1 tag.
+	id3v1TitleLength   = 30
+	id3v1ArtistLength  = 30
+	id3v1AlbumLength   = 30
+	id3v1YearLength    = 4
+	id3v1CommentLength = 28
+
+	// id3v1UnknownGenre is the genre byte used when the genre can't be mapped to the ID3v1 list.
+	id3v1UnknownGenre = 255
+)
+
+// ID3v1Genres is the list of genres defined by ID3v1 and its Winamp extensions.
+// The index in the slice is the genre byte stored in the ID3v1 tag.
+var ID3v1Genres = []string{
+	"Blues", "Classic Rock", "Country", "Dance", "Disco", "Funk", "Grunge", "Hip-Hop",
+	"Jazz", "Metal", "New Age", "Oldies", "Other", "Pop", "R&B", "Rap",
+	"Reggae", "Rock", "Techno", "Industrial", "Alternative", "Ska", "Death Metal", "Pranks",
+	"Soundtrack", "Euro-Techno", "Ambient", "Trip-Hop", "Vocal", "Jazz+Funk", "Fusion", "Trance",
+	"Classical", "Instrumental", "Acid", "House", "Game", "Sound Clip", "Gospel", "Noise",
+	"Alternative Rock", "Bass", "Soul", "Punk", "Space", "Meditative", "Instrumental Pop", "Instrumental Rock",
+	"Ethnic", "Gothic", "Darkwave", "Techno-Industrial", "Electronic", "Pop-Folk", "Eurodance", "Dream",
+	"Southern Rock", "Comedy", "Cult", "Gangsta", "Top 40", "Christian Rap", "Pop/Funk", "Jungle",
+	"Native American", "Cabaret", "New Wave", "Psychedelic", "Rave", "Showtunes", "Trailer", "Lo-Fi",
+	"Tribal", "Acid Punk", "Acid Jazz", "Polka", "Retro", "Musical", "Rock & Roll", "Hard Rock",
+	"Folk", "Folk-Rock", "National Folk", "Swing", "Fast Fusion", "Bebop", "Latin", "Revival",
+	"Celtic", "Bluegrass", "Avantgarde", "Gothic Rock", "Progressive Rock", "Psychedelic Rock",
+	"Symphonic Rock", "Slow Rock",
+	"Big Band", "Chorus", "Easy Listening", "Acoustic", "Humour", "Speech", "Chanson", "Opera",
+	"Chamber Music", "Sonata", "Symphony", "Booty Bass", "Primus", "Porn Groove", "Satire", "Slow Jam",
+	"Club", "Tango", "Samba", "Folklore", "Ballad", "Power Ballad", "Rhythmic Soul", "Freestyle",
+	"Duet", "Punk Rock", "Drum Solo", "A Cappella", "Euro-House", "Dance Hall", "Goa", "Drum & Bass",
+	"Club-House", "Hardcore Techno", "Terror", "Indie", "BritPop", "Negerpunk", "Polsk Punk", "Beat",
+	"Christian Gangsta Rap", "Heavy Metal", "Black Metal", "Crossover", "Contemporary Christian",
+	"Christian Rock", "Merengue", "Salsa",
+	"Thrash Metal", "Anime", "Jpop", "Synthpop", "Abstract", "Art Rock", "Baroque", "Bhangra",
+	"Big Beat", "Breakbeat", "Chillout", "Downtempo", "Dub", "EBM", "Eclectic", "Electro",
+	"Electroclash", "Emo", "Experimental", "Garage", "Global", "IDM", "Illbient", "Industro-Goth",
+	"Jam Band", "Krautrock", "Leftfield", "Lounge", "Math Rock", "New Romantic", "Nu-Breakz", "Post-Punk",
+	"Post-Rock", "Psytrance", "Shoegaze", "Space Rock", "Trop Rock", "World Music", "Neoclassical", "Audiobook",
+	"Audio Theatre", "Neue Deutsche Welle", "Podcast", "Indie Rock", "G-Funk", "Dubstep", "Garage Rock", "Psybient",
+}
+
+// id3v1Tag holds the values of an ID3v1.1 tag.
+type id3v1Tag struct {
+	Title   string
+	Artist  string
+	Album   string
+	Year    string
+	Comment string
+	Track   byte
+	Genre   byte
+}
+
+// id3v1Tag builds the ID3v1.1 representation of the tag's basic frames.
+func (tag *Tag) id3v1Tag() id3v1Tag {
+	v1 := id3v1Tag{
+		Title:  tag.Title(),
+		Artist: tag.Artist(),
+		Album:  tag.Album(),
+		Year:   tag.Year(),
+		Genre:  id3v1GenreByte(tag.Genre()),
+	}
+
+	// ID3v2.4 stores the recording time (TDRC), which starts with the year.
+	if v1.Year == "" && tag.version == 4 {
+		v1.Year = tag.GetTextFrame("TDRC").Text
+	}
+
+	// Use the first comment frame, if any.
+	if comments := tag.GetFrames(tag.CommonID("Comments")); len(comments) > 0 {
+		if cf, ok := comments[0].(CommentFrame); ok {
+			v1.Comment = cf.Text
+		}
+	}
+
+	// The track number may be stored as "3/12", only the first part is needed.
+	trackNumber, _, _ := strings.Cut(tag.GetTextFrame(tag.CommonID("Track number/Position in set")).Text, "/")
+	if track, err := strconv.Atoi(strings.TrimSpace(trackNumber)); err == nil && track > 0 && track <= 255 {
+		v1.Track = byte(track)
+	}
+
+	return v1
+}
+
+// bytes returns the 128-byte ID3v1.1 representation of the tag.
+// Text values are converted to ISO-8859-1 and truncated to the field limits.
+func (v1 id3v1Tag) bytes() []byte {
+	b := make([]byte, 0, id3v1TagSize)
+
+	b = append(b, id3v1Identifier...)
+	b = appendID3v1Field(b, v1.Title, id3v1TitleLength)
+	b = appendID3v1Field(b, v1.Artist, id3v1ArtistLength)
+	b = appendID3v1Field(b, v1.Album, id3v1AlbumLength)
+	b = appendID3v1Field(b, v1.Year, id3v1YearLength)
+	b = appendID3v1Field(b, v1.Comment, id3v1CommentLength)
+
+	// ID3v1.1 uses a zero byte followed by the track number at the end of the comment field.
+	b = append(b, 0, v1.Track, v1.Genre)
+
+	return b
+}
+
+// appendID3v1Field appends s converted to ISO-8859-1 to b,
+// truncating or zero-padding it to exactly length bytes.
+func appendID3v1Field(b []byte, s string, length int) []byte {
+	n := 0
+
+	for _, r := range s {
+		if n == length {
+			break
+		}
+
+		// Characters outside of ISO-8859-1 can't be represented in ID3v1.
+		if r > 0xFF {
+			r = '?'
+		}
+
+		b = append(b, byte(r))
+		n++
+	}
+
+	for ; n < length; n++ {
+		b = append(b, 0)
+	}
+
+	return b
+}
+
+// id3v1GenreByte maps the value of a content type frame to an ID3v1 genre byte.
+// It understands plain genre names as well as numeric references like "(17)" or "17".
+func id3v1GenreByte(genre string) byte {
+	genre = strings.TrimSpace(genre)
+	if genre == "" {
+		return id3v1UnknownGenre
+	}
+
+	// Numeric references, e.g. "(17)", "(17)Rock" or "17".
+	numeric := genre
+	if strings.HasPrefix(numeric, "(") {
+		if end := strings.IndexByte(numeric, ')'); end > 0 {
+			numeric = numeric[1:end]
+		}
+	}
+
+	if n, err := strconv.Atoi(numeric); err == nil && n >= 0 && n < len(ID3v1Genres) {
+		return byte(n)
+	}
+
+	for i, name := range ID3v1Genres {
+		if strings.EqualFold(name, genre) {
+			return byte(i)
+		}
+	}
+
+	return id3v1UnknownGenre
+}
+
+// hasID3v1Tag reports whether the data of size `size` read from `rs` ends with an ID3v1 tag.
+func hasID3v1Tag(rs io.ReaderAt, size int64) (bool, error) {
+	if size < id3v1TagSize {
+		return false, nil
+	}
+
+	// The end of an APE tag may accidentally contain "TAG" at the ID3v1 position.
+	if hasAPE, err := endsWithAPEFooter(rs, size); err != nil || hasAPE {
+		return false, err
+	}
+
+	identifier := make([]byte, len(id3v1Identifier))
+
+	if _, err := rs.ReadAt(identifier, size-id3v1TagSize); err != nil {
+		return false, err
+	}
+
+	return string(identifier) == id3v1Identifier, nil
+}
@@ -0,0 +1,99 @@
+package id3v2
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestID3v1GenresCount(t *testing.T) {
+	t.Parallel()
+
+	if len(ID3v1Genres) != 192 {
+		t.Errorf("Expected 192 genres, got %v", len(ID3v1Genres))
+	}
+}
+
+func TestID3v1GenreByte(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]byte{
+		"":          id3v1UnknownGenre,
+		"Rock":      17,
+		"rock":      17,
+		"(17)":      17,
+		"(17)Rock":  17,
+		"9":         9,
+		"Psybient":  191,
+		"Not Exist": id3v1UnknownGenre,
+	}
+
+	for genre, expected := range tests {
+		if got := id3v1GenreByte(genre); got != expected {
+			t.Errorf("Genre %q: expected %v, got %v", genre, expected, got)
+		}
+	}
+}
+
+func TestSaveWithID3v1(t *testing.T) {
+	tmpFile, err := prepareTestFile("id3v1-*.mp3")
+	if err != nil {
+		t.Fatal("Error while preparing test file:", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	tmpFile.Close()
+
+	tag, err := Open(tmpFile.Name(), parseOpts)
+	if err != nil {
+		t.Fatal("Error while opening mp3 file:", err)
+	}
+
+	longTitle := "A very long title that doesn't fit into thirty bytes"
+	tag.SetTitle(longTitle)
+	tag.SetArtist("Artíst ☃")
+	tag.SetGenre("(17)")
+	tag.AddTextFrame(tag.CommonID("Track number/Position in set"), EncodingUTF8, "3/12")
+
+	if err = tag.SaveWithID3v1(); err != nil {
+		t.Fatal("Error while saving tag:", err)
+	}
+
+	// Saving twice must replace the ID3v1 tag rather than append a second one.
+	if err = tag.SaveWithID3v1(); err != nil {
+		t.Fatal("Error while saving tag:", err)
+	}
+
+	tag.Close()
+
+	data, err := os.ReadFile(tmpFile.Name())
+	if err != nil {
+		t.Fatal("Error while reading file:", err)
+	}
+
+	v1 := data[len(data)-id3v1TagSize:]
+	if string(v1[:3]) != id3v1Identifier {
+		t.Fatalf("Expected ID3v1 identifier at the end of file, got %q", v1[:3])
+	}
+
+	if !bytes.Equal(v1[3:33], []byte(longTitle[:30])) {
+		t.Errorf("Expected title %q, got %q", longTitle[:30], v1[3:33])
+	}
+
+	expectedArtist := append([]byte{'A', 'r', 't', 0xED, 's', 't', ' ', '?'}, make([]byte, 22)...)
+	if !bytes.Equal(v1[33:63], expectedArtist) {
+		t.Errorf("Expected artist %q, got %q", expectedArtist, v1[33:63])
+	}
+
+	if v1[125] != 0 || v1[126] != 3 {
+		t.Errorf("Expected track 3, got %v (marker %v)", v1[126], v1[125])
+	}
+
+	if v1[127] != 17 {
+		t.Errorf("Expected genre 17, got %v", v1[127])
+	}
+
+	if string(data[len(data)-2*id3v1TagSize:len(data)-2*id3v1TagSize+3]) == id3v1Identifier {
+		t.Error("Expected ID3v1 tag to be replaced, but found a duplicate")
+	}
+}
@@ -1,11 +1,17 @@
 package id3v2
 
 import (
+	"bytes"
+	"errors"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 )
 
+// ErrStopParsing can be returned by the callback of ParseFramesFunc to stop parsing without an error.
+var ErrStopParsing = errors.New("stop parsing")
+
 // Available picture types for picture frames (APIC frames).
 // These constants define the type of image stored in the picture frame.
 const (
@@ -36,6 +42,10 @@ const (
 // If the file does not contain an ID3v2 tag, a new one is created with ID3v2.4 version.
 // The `opts` parameter controls parsing behavior, such as whether to parse all frames or specific ones.
 // Returns a pointer to the Tag and an error if the file cannot be opened or parsed.
+// If a frame fails to parse, the returned tag holds the frames parsed before it
+// and the error wraps ErrIncompleteTag, so the readable part can be salvaged.
+// If the file ends before the end of the tag, the error wraps ErrTruncatedTag as well.
+// The file must be closed with tag.Close in that case as well.
 func Open(name string, opts Options) (*Tag, error) {
 	// Open the file and clean the path to prevent directory traversal issues.
 	file, err := os.Open(filepath.Clean(name))
@@ -47,10 +57,50 @@ func Open(name string, opts Options) (*Tag, error) {
 	return ParseReader(file, opts)
 }
 
+// OpenFS opens the file specified by `name` from the file system `fsys` and parses its ID3v2 tag.
+// It allows reading tags from embed.FS, zip archives or any other fs.FS implementation.
+// File systems are read-only by design, so Save on the returned tag returns ErrReadOnlyFS.
+// Close must still be called to release the underlying file.
+func OpenFS(fsys fs.FS, name string, opts Options) (*Tag, error) {
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the file's content and remember where it came from.
+	tag, err := ParseReader(file, opts)
+	tag.fsys = fsys
+
+	return tag, err
+}
+
+// RemoveTag removes the ID3v2 tag from the MP3 file specified by `name`.
+// The file is rewritten to contain only the music part. If the file has no ID3v2 tag,
+// it is left untouched.
+func RemoveTag(name string) error {
+	return RemoveTagWithOptions(name, SaveOptions{})
+}
+
+// RemoveTagWithOptions removes the ID3v2 tag from the MP3 file specified by `name` like RemoveTag,
+// using the provided options, e.g. to also strip ID3v1 and Lyrics3 trailers.
+func RemoveTagWithOptions(name string, opts SaveOptions) error {
+	// Only the tag header is needed to know where the music part starts.
+	tag, err := Open(name, Options{Parse: false})
+	if err != nil {
+		return err
+	}
+	defer tag.Close()
+
+	return tag.DeleteTagWithOptions(opts)
+}
+
 // ParseReader reads from the provided `io.Reader` and parses the ID3v2 tag.
 // If no tag is found, a new ID3v2.4 tag is created.
 // The `opts` parameter controls parsing behavior, such as whether to parse all frames or specific ones.
 // Returns a pointer to the Tag and an error if parsing fails.
+// If a frame fails to parse, the returned tag holds the frames parsed before it
+// and the error wraps ErrIncompleteTag, so the readable part can be salvaged.
+// If the file ends before the end of the tag, the error wraps ErrTruncatedTag as well.
 func ParseReader(rd io.Reader, opts Options) (*Tag, error) {
 	// Create a new empty tag and parse the reader's content into it.
 	tag := NewEmptyTag()
@@ -59,6 +109,41 @@ func ParseReader(rd io.Reader, opts Options) (*Tag, error) {
 	return tag, err
 }
 
+// ParseFramesFunc parses the ID3v2 tag from the provided reader and calls fn for every parsed frame
+// in the order of occurrence, without building a Tag. It's the lowest-overhead way to scan
+// massive libraries for a single field, especially combined with Options.ParseFrames.
+// The frames are always parsed, so opts.Parse is ignored, and options that work on the whole tag
+// (e.g., FixPictureMimeTypes) don't apply.
+// If fn returns ErrStopParsing, parsing stops and ParseFramesFunc returns nil.
+// Other errors returned by fn stop parsing and are returned as is.
+func ParseFramesFunc(rd io.Reader, opts Options, fn func(id string, frame Framer) error) error {
+	var fnErr error
+
+	opts.Parse = true
+
+	err := new(Tag).parseWith(rd, opts, func(id string, frame Framer) error {
+		fnErr = fn(id, frame)
+
+		return fnErr
+	})
+
+	switch {
+	case errors.Is(fnErr, ErrStopParsing):
+		return nil
+	case fnErr != nil:
+		return fnErr
+	default:
+		return err
+	}
+}
+
+// ParseBytes parses the ID3v2 tag from the provided byte slice.
+// It's a convenience wrapper around ParseReader for tags stored in databases or message queues.
+// The returned tag isn't bound to a file, so use WriteTo or Bytes to serialize it back.
+func ParseBytes(data []byte, opts Options) (*Tag, error) {
+	return ParseReader(bytes.NewReader(data), opts)
+}
+
 // NewEmptyTag creates and returns a new empty ID3v2.4 tag.
 // The tag has no frames and no associated reader.
 // This is useful for creating a new tag from scratch.
@@ -0,0 +1,110 @@
+package id3v2test
+
+import (
+	"bytes"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/oshokin/id3v2/v2"
+)
+
+// UpdateGoldenEnv is the environment variable that makes AssertGolden rewrite the golden files
+// instead of comparing with them, e.g. ID3V2TEST_UPDATE=1 go test ./....
+const UpdateGoldenEnv = "ID3V2TEST_UPDATE"
+
+// AssertEqualTags reports an error for every frame that differs between the tags.
+// Frames are compared with id3v2.Equal. The order of frames with the same ID doesn't matter.
+func AssertEqualTags(tb testing.TB, got, want *id3v2.Tag) {
+	tb.Helper()
+
+	gotFrames, wantFrames := got.AllFrames(), want.AllFrames()
+
+	ids := slices.Sorted(maps.Keys(gotFrames))
+	for id := range wantFrames {
+		if _, ok := gotFrames[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		for _, f := range missingFrames(wantFrames[id], gotFrames[id]) {
+			tb.Errorf("Frame %s is missing: %+v", id, f)
+		}
+
+		for _, f := range missingFrames(gotFrames[id], wantFrames[id]) {
+			tb.Errorf("Frame %s is unexpected: %+v", id, f)
+		}
+	}
+}
+
+// missingFrames returns the frames of want that have no equal frame in got.
+// Each frame of got matches a single frame of want.
+func missingFrames(want, got []id3v2.Framer) []id3v2.Framer {
+	var missing []id3v2.Framer
+
+	matched := make([]bool, len(got))
+
+	for _, w := range want {
+		found := false
+
+		for i, g := range got {
+			if !matched[i] && id3v2.Equal(w, g) {
+				matched[i], found = true, true
+
+				break
+			}
+		}
+
+		if !found {
+			missing = append(missing, w)
+		}
+	}
+
+	return missing
+}
+
+// AssertGolden reports an error if the tag serialized with tag.WriteToDeterministic
+// differs from the golden file at path. If the environment variable UpdateGoldenEnv is set,
+// the golden file is written instead.
+func AssertGolden(tb testing.TB, tag *id3v2.Tag, path string) {
+	tb.Helper()
+
+	var buf bytes.Buffer
+	if _, err := tag.WriteToDeterministic(&buf); err != nil {
+		tb.Fatalf("Error while writing the tag: %v", err)
+	}
+
+	if os.Getenv(UpdateGoldenEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			tb.Fatalf("Error while creating the directory of the golden file: %v", err)
+		}
+
+		if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+			tb.Fatalf("Error while writing the golden file: %v", err)
+		}
+
+		return
+	}
+
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		tb.Fatalf("Error while reading the golden file (set %s to create it): %v", UpdateGoldenEnv, err)
+	}
+
+	if bytes.Equal(buf.Bytes(), golden) {
+		return
+	}
+
+	goldenTag, err := id3v2.ParseBytes(golden, id3v2.Options{Parse: true})
+	if err != nil {
+		tb.Fatalf("Tag differs from the golden file %s, which can't be parsed: %v", path, err)
+	}
+
+	tb.Errorf("Tag differs from the golden file %s", path)
+	AssertEqualTags(tb, tag, goldenTag)
+}
@@ -0,0 +1,72 @@
+package id3v2test
+
+import "bytes"
+
+// Offsets and sizes of the ID3v2 tag header and of the header of the first frame.
+const (
+	tagHeaderSize   = 10
+	tagSizeOffset   = 6
+	frameIDOffset   = tagHeaderSize
+	frameSizeOffset = frameIDOffset + 4
+	frameHeaderEnd  = frameSizeOffset + 6
+)
+
+// TruncateTag returns a copy of the data, which must start with an ID3v2 tag,
+// cut in the middle of the tag, like a broken download. Data that is too short is returned as is.
+func TruncateTag(data []byte) []byte {
+	if len(data) < tagHeaderSize {
+		return bytes.Clone(data)
+	}
+
+	end := min(tagHeaderSize+tagSize(data)/2, len(data))
+
+	return bytes.Clone(data[:end])
+}
+
+// SetTagSize returns a copy of the data, which must start with an ID3v2 tag,
+// with the size declared in the tag header replaced, e.g. to simulate encoders writing wrong sizes.
+func SetTagSize(data []byte, size int) []byte {
+	out := bytes.Clone(data)
+	if len(out) >= tagHeaderSize {
+		putSynchsafe(out[tagSizeOffset:tagHeaderSize], size)
+	}
+
+	return out
+}
+
+// OversizeFirstFrame returns a copy of the data, which must start with an ID3v2 tag,
+// with the size of the first frame exceeding the tag.
+func OversizeFirstFrame(data []byte) []byte {
+	out := bytes.Clone(data)
+	if len(out) >= frameHeaderEnd {
+		putSynchsafe(out[frameSizeOffset:frameSizeOffset+4], tagSize(out)+1)
+	}
+
+	return out
+}
+
+// GarbleFirstFrameID returns a copy of the data, which must start with an ID3v2 tag,
+// with the ID of the first frame replaced with bytes that aren't a valid frame ID.
+func GarbleFirstFrameID(data []byte) []byte {
+	out := bytes.Clone(data)
+	if len(out) >= frameHeaderEnd {
+		copy(out[frameIDOffset:frameSizeOffset], "\x01#?\xFF")
+	}
+
+	return out
+}
+
+// tagSize returns the size of the tag body declared in the tag header.
+func tagSize(data []byte) int {
+	b := data[tagSizeOffset:tagHeaderSize]
+
+	return int(b[0])<<21 | int(b[1])<<14 | int(b[2])<<7 | int(b[3])
+}
+
+// putSynchsafe writes the size as a 4-byte synchsafe integer.
+// Read as a plain integer, like ID3v2.3 frame sizes, it's never smaller than the size.
+func putSynchsafe(b []byte, size int) {
+	for i := range 4 {
+		b[3-i] = byte(size >> (7 * i) & 0x7F)
+	}
+}
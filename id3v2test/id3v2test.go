@@ -0,0 +1,58 @@
+// Package id3v2test provides helpers for testing code built on the id3v2 package:
+// builders of synthetic MP3 files, assertions comparing tags with expected and golden tags,
+// and generators of corrupted tags. They let projects test their tagging logic
+// without shipping binary fixtures.
+package id3v2test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/oshokin/id3v2/v2"
+)
+
+// MPEGFrameSize is the size in bytes of the MPEG frames generated by MPEGFrames.
+const MPEGFrameSize = 417
+
+// MPEGFrames returns count MPEG-1 Layer III frames of silence (128 kbps, 44.1 kHz, stereo),
+// which is about 26 milliseconds of audio per frame.
+func MPEGFrames(count int) []byte {
+	frame := make([]byte, MPEGFrameSize)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0x00})
+
+	return bytes.Repeat(frame, count)
+}
+
+// MP3 returns a synthetic MP3 file: the serialized tag followed by count MPEG frames.
+// If tag is nil, the file has no tag.
+func MP3(tb testing.TB, tag *id3v2.Tag, count int) []byte {
+	tb.Helper()
+
+	var buf bytes.Buffer
+
+	if tag != nil {
+		if _, err := tag.WriteTo(&buf); err != nil {
+			tb.Fatalf("Error while writing the tag: %v", err)
+		}
+	}
+
+	buf.Write(MPEGFrames(count))
+
+	return buf.Bytes()
+}
+
+// WriteMP3 writes the synthetic MP3 file returned by MP3 to a temporary directory
+// and returns its path. The file is removed when the test finishes.
+func WriteMP3(tb testing.TB, tag *id3v2.Tag, count int) string {
+	tb.Helper()
+
+	path := filepath.Join(tb.TempDir(), "test.mp3")
+
+	if err := os.WriteFile(path, MP3(tb, tag, count), 0o600); err != nil {
+		tb.Fatalf("Error while writing the file: %v", err)
+	}
+
+	return path
+}
@@ -0,0 +1,146 @@
+package id3v2test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/oshokin/id3v2/v2"
+)
+
+func newTestTag() *id3v2.Tag {
+	tag := id3v2.NewEmptyTag()
+	tag.SetTitle("Title")
+	tag.SetArtist("Artist")
+	tag.AddCommentFrame(id3v2.CommentFrame{
+		Encoding: id3v2.EncodingUTF8,
+		Language: "eng",
+		Text:     "Comment",
+	})
+
+	return tag
+}
+
+func TestMP3(t *testing.T) {
+	t.Parallel()
+
+	tag := newTestTag()
+
+	path := WriteMP3(t, tag, 100)
+
+	parsed, err := id3v2.Open(path, id3v2.Options{Parse: true})
+	if err != nil {
+		t.Fatalf("Error while opening the file: %v", err)
+	}
+	defer parsed.Close()
+
+	AssertEqualTags(t, parsed, tag)
+
+	info, err := parsed.AudioInfo()
+	if err != nil {
+		t.Fatalf("Error while reading audio info: %v", err)
+	}
+
+	if info.Frames != 100 {
+		t.Errorf("Expected 100 MPEG frames, got %d", info.Frames)
+	}
+
+	if data := MP3(t, nil, 2); !bytes.Equal(data, MPEGFrames(2)) {
+		t.Errorf("Expected only MPEG frames in a file without a tag")
+	}
+}
+
+func TestAssertEqualTags(t *testing.T) {
+	t.Parallel()
+
+	want := newTestTag()
+
+	got := newTestTag()
+	got.SetTitle("Other")
+	got.AddCommentFrame(id3v2.CommentFrame{Encoding: id3v2.EncodingUTF8, Language: "deu", Text: "Kommentar"})
+
+	recorder := &errorRecorder{TB: t}
+	AssertEqualTags(recorder, got, want)
+
+	// The title differs in both directions, and the German comment is unexpected.
+	if recorder.errors != 3 {
+		t.Errorf("Expected 3 reported differences, got %d", recorder.errors)
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	t.Parallel()
+
+	tag := newTestTag()
+	path := filepath.Join(t.TempDir(), "golden.id3")
+
+	var buf bytes.Buffer
+	if _, err := tag.WriteToDeterministic(&buf); err != nil {
+		t.Fatalf("Error while writing the tag: %v", err)
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatalf("Error while writing the golden file: %v", err)
+	}
+
+	AssertGolden(t, tag, path)
+
+	tag.SetAlbum("Album")
+
+	recorder := &errorRecorder{TB: t}
+	AssertGolden(recorder, tag, path)
+
+	if recorder.errors == 0 {
+		t.Error("Expected the changed tag to differ from the golden file")
+	}
+}
+
+func TestCorruptions(t *testing.T) {
+	t.Parallel()
+
+	data := MP3(t, newTestTag(), 10)
+
+	_, err := id3v2.ParseBytes(TruncateTag(data), id3v2.Options{Parse: true})
+	if !errors.Is(err, id3v2.ErrTruncatedTag) {
+		t.Errorf("Expected ErrTruncatedTag for a truncated tag, got %v", err)
+	}
+
+	if _, err = id3v2.ParseBytes(OversizeFirstFrame(data), id3v2.Options{Parse: true}); err == nil {
+		t.Error("Expected an error for an oversized frame")
+	}
+
+	tag, err := id3v2.ParseBytes(GarbleFirstFrameID(data), id3v2.Options{Parse: true, Lenient: true})
+	if err != nil {
+		t.Fatalf("Error while parsing a tag with a garbled frame ID leniently: %v", err)
+	}
+
+	if warnings := tag.ParseWarnings(); len(warnings) != 1 {
+		t.Errorf("Expected 1 warning about the garbled frame, got %v", warnings)
+	}
+
+	resized := SetTagSize(data, 5)
+	if _, err = id3v2.ParseBytes(resized, id3v2.Options{Parse: true}); err == nil {
+		t.Error("Expected an error for a tag smaller than its first frame")
+	}
+
+	if !bytes.Equal(data, MP3(t, newTestTag(), 10)) {
+		t.Error("Expected the original data to be unchanged")
+	}
+}
+
+// errorRecorder counts the errors reported by assertions instead of failing the test.
+type errorRecorder struct {
+	testing.TB
+
+	errors int
+}
+
+func (r *errorRecorder) Errorf(string, ...any) {
+	r.errors++
+}
+
+func (r *errorRecorder) Error(...any) {
+	r.errors++
+}
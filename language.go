@@ -0,0 +1,76 @@
+package id3v2
+
+//go:generate go run gen_language_codes.go
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// UndeterminedISO6392Code is the ISO 639-2 code for an undetermined language.
+const UndeterminedISO6392Code = "und"
+
+// IsValidLanguageCode reports whether the code is a lowercase ISO 639-2 language code,
+// either bibliographic (e.g., "ger") or terminologic (e.g., "deu").
+// Codes reserved for local use ("qaa" to "qtz") are valid too.
+func IsValidLanguageCode(code string) bool {
+	if _, ok := iso6392Codes[code]; ok {
+		return true
+	}
+
+	return len(code) == 3 && code[0] == 'q' && code[1] >= 'a' && code[1] <= 't' && code[2] >= 'a' && code[2] <= 'z'
+}
+
+// normalizeFrameLanguage returns the frame with its language code coerced by NormalizeLanguageCode
+// if the frame has a language code that can be coerced.
+func normalizeFrameLanguage(frame Framer) Framer {
+	switch f := frame.(type) {
+	case CommentFrame:
+		if code, ok := NormalizeLanguageCode(f.Language); ok {
+			f.Language = code
+		}
+
+		return f
+	case UnsynchronisedLyricsFrame:
+		if code, ok := NormalizeLanguageCode(f.Language); ok {
+			f.Language = code
+		}
+
+		return f
+	case SynchronisedLyricsFrame:
+		if code, ok := NormalizeLanguageCode(f.Language); ok {
+			f.Language = code
+		}
+
+		return f
+	default:
+		return frame
+	}
+}
+
+// NormalizeLanguageCode coerces a language code written by lenient taggers to a valid ISO 639-2 code.
+// Uppercase codes are lowercased, ISO 639-1 codes are converted (e.g., "en" to "eng")
+// and the widespread "XXX" placeholder becomes "und".
+// It returns false if the code can't be coerced.
+func NormalizeLanguageCode(code string) (string, bool) {
+	if IsValidLanguageCode(code) {
+		return code, true
+	}
+
+	lower := strings.ToLower(strings.TrimSpace(code))
+
+	switch {
+	case IsValidLanguageCode(lower):
+		return lower, true
+	case lower == "xxx":
+		return UndeterminedISO6392Code, true
+	case len(lower) == 2:
+		base, err := language.ParseBase(lower)
+		if err == nil && IsValidLanguageCode(base.ISO3()) {
+			return base.ISO3(), true
+		}
+	}
+
+	return "", false
+}
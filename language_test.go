@@ -0,0 +1,77 @@
+package id3v2
+
+import "testing"
+
+func TestIsValidLanguageCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		code     string
+		expected bool
+	}{
+		{"eng", true},
+		{"ger", true},
+		{"deu", true},
+		{"und", true},
+		{"qab", true},
+		{"qua", false},
+		{"ENG", false},
+		{"en", false},
+		{"xxx", false},
+		{"abc", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValidLanguageCode(tt.code); got != tt.expected {
+			t.Errorf("Expected %v for %q, got %v", tt.expected, tt.code, got)
+		}
+	}
+}
+
+func TestNormalizeLanguageCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		code       string
+		expected   string
+		expectedOK bool
+	}{
+		{"eng", "eng", true},
+		{"ENG", "eng", true},
+		{"en", "eng", true},
+		{"DE", "deu", true},
+		{"XXX", "und", true},
+		{"english", "", false},
+		{"zz", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := NormalizeLanguageCode(tt.code)
+		if got != tt.expected || ok != tt.expectedOK {
+			t.Errorf("Expected %q, %v for %q, got %q, %v", tt.expected, tt.expectedOK, tt.code, got, ok)
+		}
+	}
+}
+
+func TestParseLenientLanguageCodes(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.AddUnsynchronisedLyricsFrame(UnsynchronisedLyricsFrame{Encoding: EncodingUTF8, Language: "XXX", Lyrics: "Lyrics"})
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := ParseBytes(data, Options{Parse: true, Lenient: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uslf, _ := parsed.GetLastFrame(parsed.CommonID("Unsynchronised lyrics/text transcription")).(UnsynchronisedLyricsFrame)
+	if uslf.Language != UndeterminedISO6392Code {
+		t.Errorf("Expected %q, got %q", UndeterminedISO6392Code, uslf.Language)
+	}
+}
@@ -2,11 +2,13 @@ package id3v2
 
 import "io"
 
-// LinkFrame represents a frame that contains a URL or link.
-// It is used for frames like "WXXX" (User-defined URL link) in ID3v2 tags.
+// LinkFrame represents a user-defined URL link frame (WXXX) in an ID3v2 tag.
+// It is also used as a subframe of chapter frames to link a chapter to a web page.
+// The description is encoded with the frame's encoding, while the URL is always stored in ISO-8859-1.
 type LinkFrame struct {
-	Encoding Encoding // The text encoding used for the URL.
-	URL      string   // The actual URL or link.
+	Encoding    Encoding // The text encoding used for the description.
+	Description string   // A description of the link (optional).
+	URL         string   // The actual URL or link.
 }
 
 // linkFrameUniqueIdentifier is a constant used to uniquely identify LinkFrame instances.
@@ -14,9 +16,10 @@ type LinkFrame struct {
 const linkFrameUniqueIdentifier = "ID"
 
 // Size calculates the total size of the LinkFrame in bytes.
-// This includes the encoding byte, the encoded URL, and the termination bytes.
+// This includes the encoding byte, the encoded description, the termination bytes and the URL.
 func (lf LinkFrame) Size() int {
-	return 1 + encodedSize(lf.URL, lf.Encoding) + len(lf.Encoding.TerminationBytes)
+	return 1 + encodedSize(lf.Description, lf.Encoding) + len(lf.Encoding.TerminationBytes) +
+		encodedSize(lf.URL, EncodingISO)
 }
 
 // UniqueIdentifier returns a unique identifier for the LinkFrame.
@@ -26,15 +29,15 @@ func (lf LinkFrame) UniqueIdentifier() string {
 }
 
 // WriteTo writes the LinkFrame to the provided io.Writer.
-// It encodes the URL using the specified encoding and writes the frame's data.
+// It encodes the description using the specified encoding, the URL in ISO-8859-1, and writes the frame's data.
 // Returns the number of bytes written and any error encountered.
 func (lf LinkFrame) WriteTo(w io.Writer) (int64, error) {
 	return useBufferedWriter(w, func(bw *bufferedWriter) error {
 		// Write the encoding byte.
 		bw.WriteByte(lf.Encoding.Key)
 
-		// Encode and write the URL.
-		bw.EncodeAndWriteText(lf.URL, lf.Encoding)
+		// Encode and write the description.
+		bw.EncodeAndWriteText(lf.Description, lf.Encoding)
 
 		// Write the termination bytes for the encoding.
 		_, err := bw.Write(lf.Encoding.TerminationBytes)
@@ -42,18 +45,24 @@ func (lf LinkFrame) WriteTo(w io.Writer) (int64, error) {
 			return err
 		}
 
+		// Write the URL, which is always ISO-8859-1 encoded.
+		bw.EncodeAndWriteText(lf.URL, EncodingISO)
+
 		return nil
 	})
 }
 
 // parseLinkFrame parses a LinkFrame from a bufferedReader.
-// It reads the encoding, URL, and termination bytes, and constructs a LinkFrame.
+// It reads the encoding, the description and the URL, and constructs a LinkFrame.
 // Returns the parsed LinkFrame and any error encountered.
 func parseLinkFrame(br *bufferedReader) (Framer, error) {
 	// Read the encoding byte and determine the encoding type.
 	encoding := getEncoding(br.ReadByte())
 
-	// Check for errors after reading the encoding byte.
+	// Read the description.
+	description := br.ReadText(encoding)
+
+	// Check for errors after reading the encoding byte and the description.
 	if br.Err() != nil {
 		return nil, br.Err()
 	}
@@ -67,10 +76,11 @@ func parseLinkFrame(br *bufferedReader) (Framer, error) {
 		return nil, err
 	}
 
-	// Decode the URL from the buffer using the specified encoding.
+	// Decode the description and the URL.
 	lf := LinkFrame{
-		Encoding: encoding,
-		URL:      decodeText(buf.Bytes(), encoding),
+		Encoding:    encoding,
+		Description: decodeText(description, encoding),
+		URL:         decodeText(buf.Bytes(), EncodingISO),
 	}
 
 	return lf, nil
@@ -0,0 +1,138 @@
+package id3v2
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"code.cloudfoundry.org/bytefmt"
+)
+
+// LintRule identifies a best-practices rule checked by Lint.
+type LintRule string
+
+// Rules checked by Lint.
+const (
+	// LintRuleLargeFrontCover reports front covers larger than maxFrontCoverSize.
+	LintRuleLargeFrontCover LintRule = "large-front-cover"
+	// LintRuleDeprecatedFrame reports ID3v2.3 frames present in an ID3v2.4 tag.
+	LintRuleDeprecatedFrame LintRule = "deprecated-frame"
+	// LintRuleConflictingYear reports TYER used alongside TDRC.
+	LintRuleConflictingYear LintRule = "conflicting-year"
+	// LintRuleUnnecessaryUTF16 reports UTF-16 text that could be stored in ISO-8859-1.
+	LintRuleUnnecessaryUTF16 LintRule = "unnecessary-utf16"
+)
+
+// maxFrontCoverSize is the size of a front cover above which many players and devices struggle.
+const maxFrontCoverSize = 2 * bytefmt.MEGABYTE
+
+// LintFinding describes a violation of a best-practices rule found by Lint.
+type LintFinding struct {
+	Rule    LintRule // The violated rule.
+	FrameID string   // The ID of the frame the finding relates to.
+	Message string   // A human-readable description of the finding and how to fix it.
+}
+
+// String returns the finding formatted as "rule: frame ID: message".
+func (lf LintFinding) String() string {
+	return string(lf.Rule) + ": " + lf.FrameID + ": " + lf.Message
+}
+
+// Lint checks the tag against best practices and returns actionable findings ordered by frame ID.
+// Unlike tag.Validate, which reports violations of the standard, Lint reports valid tags
+// that are likely to cause trouble with players or waste space,
+// which makes it suitable for library hygiene tools.
+func Lint(tag *Tag) []LintFinding {
+	var findings []LintFinding
+
+	allFrames := tag.AllFrames()
+
+	for _, id := range slices.Sorted(maps.Keys(allFrames)) {
+		if tag.Version() == 4 && slices.Contains(v23OnlyFrameIDs, id) {
+			findings = append(findings, LintFinding{
+				Rule:    LintRuleDeprecatedFrame,
+				FrameID: id,
+				Message: "frame is deprecated in ID3v2.4, convert it to its ID3v2.4 equivalent or delete it",
+			})
+		}
+
+		if id == "TYER" && allFrames["TDRC"] != nil {
+			findings = append(findings, LintFinding{
+				Rule:    LintRuleConflictingYear,
+				FrameID: id,
+				Message: "frame is used alongside TDRC, players may show either of them, keep only one",
+			})
+		}
+
+		for _, frame := range allFrames[id] {
+			findings = append(findings, lintFrame(id, frame)...)
+		}
+	}
+
+	return findings
+}
+
+// lintFrame checks the rules that apply to a single frame.
+func lintFrame(id string, frame Framer) []LintFinding {
+	var findings []LintFinding
+
+	if pf, ok := frame.(PictureFrame); ok && pf.PictureType == PTFrontCover && len(pf.Picture) > maxFrontCoverSize {
+		findings = append(findings, LintFinding{
+			Rule:    LintRuleLargeFrontCover,
+			FrameID: id,
+			Message: fmt.Sprintf("front cover takes %s, downscale it below %s",
+				bytefmt.ByteSize(uint64(len(pf.Picture))), bytefmt.ByteSize(maxFrontCoverSize)),
+		})
+	}
+
+	encoding, ok := frameEncoding(frame)
+	if ok && (encoding.Equals(EncodingUTF16) || encoding.Equals(EncodingUTF16BE)) && fitsISO(frameTexts(frame)) {
+		findings = append(findings, LintFinding{
+			Rule:    LintRuleUnnecessaryUTF16,
+			FrameID: id,
+			Message: fmt.Sprintf("text is stored in %s but fits in %s, which takes half the space", encoding, EncodingISO),
+		})
+	}
+
+	return findings
+}
+
+// frameTexts returns the text fields of the frame.
+func frameTexts(frame Framer) []string {
+	switch f := frame.(type) {
+	case TextFrame:
+		return append([]string{f.Text}, f.Multi...)
+	case UserDefinedTextFrame:
+		return append([]string{f.Description, f.Value}, f.Multi...)
+	case CommentFrame:
+		return []string{f.Description, f.Text}
+	case UnsynchronisedLyricsFrame:
+		return []string{f.ContentDescriptor, f.Lyrics}
+	case SynchronisedLyricsFrame:
+		texts := []string{f.ContentDescriptor}
+		for _, st := range f.SynchronizedTexts {
+			texts = append(texts, st.Text)
+		}
+
+		return texts
+	case PictureFrame:
+		return []string{f.Description}
+	case LinkFrame:
+		return []string{f.Description}
+	default:
+		return nil
+	}
+}
+
+// fitsISO reports whether all texts can be encoded in ISO-8859-1.
+func fitsISO(texts []string) bool {
+	for _, text := range texts {
+		for _, r := range text {
+			if r > 0xFF {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,55 @@
+package id3v2
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.AddTextFrame("TDRC", EncodingUTF8, "2024")
+	tag.AddTextFrame("TYER", EncodingUTF8, "2024")
+	tag.AddTextFrame("TIT2", EncodingUTF16, "Title")
+	tag.AddTextFrame("TPE1", EncodingUTF16, "Артист")
+	tag.AddAttachedPicture(PictureFrame{
+		Encoding:    EncodingUTF8,
+		MimeType:    MimeTypeJPEG,
+		PictureType: PTFrontCover,
+		Picture:     bytes.Repeat([]byte{0}, 3*1024*1024),
+	})
+
+	expected := []struct {
+		rule    LintRule
+		frameID string
+	}{
+		{LintRuleLargeFrontCover, "APIC"},
+		{LintRuleUnnecessaryUTF16, "TIT2"},
+		{LintRuleDeprecatedFrame, "TYER"},
+		{LintRuleConflictingYear, "TYER"},
+	}
+
+	findings := Lint(tag)
+	if len(findings) != len(expected) {
+		t.Fatalf("Expected %v findings, got %v: %v", len(expected), len(findings), findings)
+	}
+
+	for i, e := range expected {
+		if findings[i].Rule != e.rule || findings[i].FrameID != e.frameID {
+			t.Errorf("Expected %v for %v, got %v", e.rule, e.frameID, findings[i])
+		}
+	}
+}
+
+func TestLintCleanTag(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetTitle("Title")
+	tag.SetArtist("Artist")
+
+	if findings := Lint(tag); len(findings) != 0 {
+		t.Errorf("Expected no findings, got %v", findings)
+	}
+}
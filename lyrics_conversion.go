@@ -0,0 +1,62 @@
+package id3v2
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrTimestampCountMismatch is returned when the number of timestamps doesn't match the number of lyrics lines.
+var ErrTimestampCountMismatch = errors.New("number of timestamps doesn't match number of lyrics lines")
+
+// ToUnsynchronised flattens the frame into unsynchronised lyrics with one entry per line.
+// The encoding, language and content descriptor are kept.
+func (sylf SynchronisedLyricsFrame) ToUnsynchronised() UnsynchronisedLyricsFrame {
+	lines := make([]string, len(sylf.SynchronizedTexts))
+	for i, st := range sylf.SynchronizedTexts {
+		lines[i] = st.Text
+	}
+
+	return UnsynchronisedLyricsFrame{
+		Encoding:          sylf.Encoding,
+		Language:          sylf.Language,
+		ContentDescriptor: sylf.ContentDescriptor,
+		Lyrics:            strings.Join(lines, "\n"),
+	}
+}
+
+// ToSynchronised builds synchronised lyrics from the frame, assigning the timestamps to its lines in order.
+// Blank lines (e.g., between verses) are skipped and don't take a timestamp.
+// The encoding, language and content descriptor are kept.
+// It returns ErrTimestampCountMismatch if the number of timestamps differs from the number of lines.
+func (uslf UnsynchronisedLyricsFrame) ToSynchronised(
+	timestamps []uint32,
+	format SYLTTimestampFormat,
+) (SynchronisedLyricsFrame, error) {
+	var lines []string
+
+	for _, line := range strings.Split(uslf.Lyrics, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	if len(lines) != len(timestamps) {
+		return SynchronisedLyricsFrame{}, fmt.Errorf("%w: %d lines, %d timestamps",
+			ErrTimestampCountMismatch, len(lines), len(timestamps))
+	}
+
+	texts := make([]SynchronizedText, len(lines))
+	for i, line := range lines {
+		texts[i] = SynchronizedText{Text: line, Timestamp: timestamps[i]}
+	}
+
+	return SynchronisedLyricsFrame{
+		Encoding:          uslf.Encoding,
+		Language:          uslf.Language,
+		TimestampFormat:   format,
+		ContentType:       SYLTLyricsContentType,
+		ContentDescriptor: uslf.ContentDescriptor,
+		SynchronizedTexts: texts,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package id3v2
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestLyricsConversion(t *testing.T) {
+	t.Parallel()
+
+	sylf := SynchronisedLyricsFrame{
+		Encoding:          EncodingUTF8,
+		Language:          EnglishISO6392Code,
+		TimestampFormat:   SYLTAbsoluteMillisecondsTimestampFormat,
+		ContentDescriptor: "Song",
+		SynchronizedTexts: []SynchronizedText{{Text: "First line", Timestamp: 1000}, {Text: "Second line", Timestamp: 2000}},
+	}
+
+	uslf := sylf.ToUnsynchronised()
+	if uslf.Lyrics != "First line\nSecond line" {
+		t.Errorf("Expected lyrics %q, got %q", "First line\nSecond line", uslf.Lyrics)
+	}
+
+	if uslf.Language != sylf.Language || uslf.ContentDescriptor != sylf.ContentDescriptor {
+		t.Errorf("Expected language and descriptor to be kept, got %q and %q", uslf.Language, uslf.ContentDescriptor)
+	}
+
+	uslf.Lyrics = "First line\r\n\r\nSecond line\n"
+
+	converted, err := uslf.ToSynchronised([]uint32{1000, 2000}, SYLTAbsoluteMillisecondsTimestampFormat)
+	if err != nil {
+		t.Fatalf("Error converting lyrics: %v", err)
+	}
+
+	if !slices.Equal(converted.SynchronizedTexts, sylf.SynchronizedTexts) {
+		t.Errorf("Expected %v, got %v", sylf.SynchronizedTexts, converted.SynchronizedTexts)
+	}
+
+	if converted.ContentType != SYLTLyricsContentType {
+		t.Errorf("Expected content type %v, got %v", SYLTLyricsContentType, converted.ContentType)
+	}
+
+	_, err = uslf.ToSynchronised([]uint32{1000}, SYLTAbsoluteMillisecondsTimestampFormat)
+	if !errors.Is(err, ErrTimestampCountMismatch) {
+		t.Errorf("Expected %v, got %v", ErrTimestampCountMismatch, err)
+	}
+}
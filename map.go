@@ -0,0 +1,224 @@
+package id3v2
+
+import (
+	"slices"
+	"strings"
+)
+
+// Prefixes of map keys for frames that are identified by a description.
+const (
+	mapKeyUserDefinedTextPrefix = UserDefinedTextFrameID + ":"
+	mapKeyCommentPrefix         = "COMM:"
+)
+
+// defaultMapCommentLanguage is the language used for comment frames created by ApplyMap.
+const defaultMapCommentLanguage = "eng"
+
+// MapKeys maps the human-readable keys used by ToMap and ApplyMap to frame descriptions
+// that can be passed to tag.CommonID.
+// Text frames that aren't listed here use their frame IDs as keys (e.g., "TSSE").
+var MapKeys = map[string]string{
+	"Album":       "Album/Movie/Show title",
+	"AlbumArtist": "Band/Orchestra/Accompaniment",
+	"Artist":      ArtistFrameDescription,
+	"BPM":         "BPM",
+	"Composer":    "Composer",
+	"Conductor":   "Conductor/performer refinement",
+	"Copyright":   "Copyright message",
+	"Disc":        "Part of a set",
+	"EncodedBy":   "Encoded by",
+	"Genre":       "Genre",
+	"Grouping":    "Content group description",
+	"ISRC":        "ISRC",
+	"Key":         "Initial key",
+	"Language":    "Language",
+	"Length":      "Length",
+	"Lyricist":    "Lyricist/Text writer",
+	"Publisher":   "Publisher",
+	"Subtitle":    "Subtitle/Description refinement",
+	"Title":       TitleFrameDescription,
+	"Track":       "Track number/Position in set",
+	"Year":        "Year",
+}
+
+// ToMap exports the textual content of the tag as a flat map with human-readable keys.
+// Text frames use the keys from MapKeys (e.g., "Artist", "Album") or their frame IDs,
+// user-defined text frames use "TXXX:<description>" and comments use "COMM:<description>".
+// Binary frames like pictures are not exported.
+// Frames with multiple values (multi-value text frames or comments in several languages)
+// produce multiple values for the same key.
+func (tag *Tag) ToMap() map[string][]string {
+	m := make(map[string][]string)
+	keys := tag.mapKeysByID()
+
+	for id, frames := range tag.AllFrames() {
+		for _, frame := range frames {
+			switch f := frame.(type) {
+			case TextFrame:
+				key, ok := keys[id]
+				if !ok {
+					key = id
+				}
+
+				m[key] = append(m[key], f.Values()...)
+			case UserDefinedTextFrame:
+				key := mapKeyUserDefinedTextPrefix + f.Description
+				m[key] = append(m[key], f.Values()...)
+			case CommentFrame:
+				key := mapKeyCommentPrefix + f.Description
+				m[key] = append(m[key], f.Text)
+			}
+		}
+	}
+
+	return m
+}
+
+// ApplyMap imports values produced by ToMap (or written by hand) into the tag.
+// Every key in the map replaces the corresponding frames, and a key with no values deletes them.
+// Frames whose keys are absent from the map are left untouched.
+// Multiple values for a text frame are stored as a multi-value frame,
+// multiple values for a comment are joined with new lines.
+// Keys that can't be mapped to a text frame, a user-defined text frame or a comment are ignored.
+func (tag *Tag) ApplyMap(m map[string][]string) {
+	for key, values := range m {
+		switch {
+		case strings.HasPrefix(key, mapKeyUserDefinedTextPrefix):
+			tag.applyUserDefinedText(strings.TrimPrefix(key, mapKeyUserDefinedTextPrefix), values)
+		case strings.HasPrefix(key, mapKeyCommentPrefix):
+			tag.applyComment(strings.TrimPrefix(key, mapKeyCommentPrefix), values)
+		default:
+			tag.applyText(key, values)
+		}
+	}
+}
+
+// mapKeysByID returns the reverse of MapKeys for the tag's version.
+func (tag *Tag) mapKeysByID() map[string]string {
+	keys := make(map[string]string, len(MapKeys))
+
+	for key, description := range MapKeys {
+		keys[tag.CommonID(description)] = key
+	}
+
+	return keys
+}
+
+// applyText replaces the text frame identified by a MapKeys key or a frame ID with the values.
+func (tag *Tag) applyText(key string, values []string) {
+	id := key
+	if description, ok := MapKeys[key]; ok {
+		id = tag.CommonID(description)
+	}
+
+	// Only text frames can be set from plain keys.
+	if len(id) != 4 || id[0] != 'T' || id == UserDefinedTextFrameID {
+		return
+	}
+
+	tag.DeleteFrames(id)
+
+	if len(values) == 0 {
+		return
+	}
+
+	tf := TextFrame{Encoding: tag.DefaultEncoding(), Text: values[0]}
+	if len(values) > 1 {
+		tf.Multi = slices.Clone(values)
+	}
+
+	tag.AddFrame(id, tf)
+}
+
+// applyUserDefinedText replaces the user-defined text frame with the description with the values.
+func (tag *Tag) applyUserDefinedText(description string, values []string) {
+	id := tag.CommonID("User defined text information frame")
+
+	tag.deleteFramesFunc(id, func(f Framer) bool {
+		udtf, ok := f.(UserDefinedTextFrame)
+
+		return ok && udtf.Description == description
+	})
+
+	if len(values) == 0 {
+		return
+	}
+
+	udtf := UserDefinedTextFrame{Encoding: tag.DefaultEncoding(), Description: description, Value: values[0]}
+	if len(values) > 1 {
+		udtf.Multi = slices.Clone(values)
+	}
+
+	tag.AddUserDefinedTextFrame(udtf)
+}
+
+// applyComment replaces the comment frames with the description with a single comment.
+// The language of the existing comment is kept.
+func (tag *Tag) applyComment(description string, values []string) {
+	id := tag.CommonID("Comments")
+	language := defaultMapCommentLanguage
+
+	tag.deleteFramesFunc(id, func(f Framer) bool {
+		cf, ok := f.(CommentFrame)
+		if ok && cf.Description == description {
+			language = cf.Language
+
+			return true
+		}
+
+		return false
+	})
+
+	if len(values) == 0 {
+		return
+	}
+
+	tag.AddCommentFrame(CommentFrame{
+		Encoding:    tag.DefaultEncoding(),
+		Language:    language,
+		Description: description,
+		Text:        strings.Join(values, "\n"),
+	})
+}
+
+// deleteFramesFunc removes the frames with the specified ID for which del returns true.
+func (tag *Tag) deleteFramesFunc(id string, del func(Framer) bool) {
+	if tag.rejectChange() {
+		return
+	}
+
+	if f, ok := tag.frames[id]; ok {
+		if del(f) {
+			delete(tag.frames, id)
+			tag.track(id, f, nil)
+		}
+
+		return
+	}
+
+	s, ok := tag.sequences[id]
+	if !ok {
+		return
+	}
+
+	var deleted []Framer
+
+	s.frames = slices.DeleteFunc(s.frames, func(f Framer) bool {
+		if del(f) {
+			deleted = append(deleted, f)
+
+			return true
+		}
+
+		return false
+	})
+
+	for _, f := range deleted {
+		tag.track(id, f, nil)
+	}
+
+	if s.Count() == 0 {
+		putSequence(s)
+		delete(tag.sequences, id)
+	}
+}
@@ -0,0 +1,69 @@
+package id3v2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestToMap(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetArtist("Artist")
+	tag.SetTitle("Title")
+	tag.AddTextFrame("TSSE", EncodingUTF8, "Encoder")
+	tag.AddFrame(tag.CommonID("Genre"), TextFrame{Encoding: EncodingUTF8, Text: "Rock", Multi: []string{"Rock", "Pop"}})
+	tag.AddUserDefinedTextFrame(UserDefinedTextFrame{Encoding: EncodingUTF8, Description: "Foo", Value: "Bar"})
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: "Note", Text: "Hi"})
+	tag.AddAttachedPicture(frontCover)
+
+	expected := map[string][]string{
+		"Artist":    {"Artist"},
+		"Title":     {"Title"},
+		"TSSE":      {"Encoder"},
+		"Genre":     {"Rock", "Pop"},
+		"TXXX:Foo":  {"Bar"},
+		"COMM:Note": {"Hi"},
+	}
+
+	if got := tag.ToMap(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestApplyMap(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetArtist("Old artist")
+	tag.SetAlbum("Album")
+	tag.AddUserDefinedTextFrame(UserDefinedTextFrame{Encoding: EncodingUTF8, Description: "Foo", Value: "Bar"})
+	tag.AddUserDefinedTextFrame(UserDefinedTextFrame{Encoding: EncodingUTF8, Description: "Keep", Value: "Me"})
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "deu", Description: "Note", Text: "Hallo"})
+
+	tag.ApplyMap(map[string][]string{
+		"Artist":    {"New artist"},
+		"Album":     nil,
+		"TPE4":      {"Remixer"},
+		"TXXX:Foo":  nil,
+		"TXXX:New":  {"Value"},
+		"COMM:Note": {"Hello", "World"},
+		"APIC":      {"ignored"},
+	})
+
+	expected := map[string][]string{
+		"Artist":    {"New artist"},
+		"TPE4":      {"Remixer"},
+		"TXXX:Keep": {"Me"},
+		"TXXX:New":  {"Value"},
+		"COMM:Note": {"Hello\nWorld"},
+	}
+
+	if got := tag.ToMap(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	if cf, _ := tag.GetLastFrame(tag.CommonID("Comments")).(CommentFrame); cf.Language != "deu" {
+		t.Errorf("Expected comment language deu, got %v", cf.Language)
+	}
+}
@@ -0,0 +1,48 @@
+package id3v2
+
+import "reflect"
+
+// Modified reports whether frames were added, removed or changed, or the version was changed,
+// since the tag was parsed or last saved. Replacing a frame with an identical one isn't a change,
+// so setting the values a tag already has keeps it unmodified.
+// Changes of the write options and the padding aren't tracked.
+// Use SaveOptions.SkipUnmodified to avoid rewriting files whose tags didn't change.
+func (tag *Tag) Modified() bool {
+	return tag.modified
+}
+
+// track marks the tag as modified if the frame after, which replaces the frame before, differs from it,
+// and notifies the observers. Either frame may be nil for added and deleted frames. It returns after.
+// The frame before is returned instead if the tag is read-only.
+func (tag *Tag) track(id string, before, after Framer) Framer {
+	if sameFrames(before, after) {
+		return after
+	}
+
+	if tag.rejectChange() {
+		return before
+	}
+
+	tag.modified = true
+
+	if before != nil {
+		tag.notifyFrameDeleted(id, before)
+	}
+
+	if after != nil {
+		tag.notifyFrameAdded(id, after)
+	}
+
+	return after
+}
+
+// sameFrames reports whether the frames have the same content, see Equal.
+// Frames of other types (e.g., returned by parsers registered with RegisterFrameParser)
+// are compared with reflect.DeepEqual, so no frame is serialized on every change of the tag.
+func sameFrames(a, b Framer) bool {
+	if equal, ok := equalTyped(a, b); ok {
+		return equal
+	}
+
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,76 @@
+package id3v2
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestModifiedAndSkipUnmodified(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "test.mp3")
+	copyTestFile(t, path)
+
+	tag, err := Open(path, parseOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tag.Close()
+
+	if tag.Modified() {
+		t.Error("Expected a freshly parsed tag to be unmodified")
+	}
+
+	tag.SetTitle(tag.Title())
+	tag.AddCommentFrame(tag.GetFrames(tag.CommonID("Comments"))[0].(CommentFrame))
+
+	if tag.Modified() {
+		t.Error("Expected setting the same values to keep the tag unmodified")
+	}
+
+	// Saving an unmodified tag doesn't replace the file.
+	before, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = tag.SaveWithOptions(SaveOptions{SkipUnmodified: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if after, _ := os.Stat(path); !os.SameFile(before, after) {
+		t.Error("Expected saving of an unmodified tag to be skipped")
+	}
+
+	tag.DeleteFrames(tag.CommonID("Comments"))
+
+	if !tag.Modified() {
+		t.Error("Expected the tag to be modified after deleting frames")
+	}
+
+	if err = tag.SaveWithOptions(SaveOptions{SkipUnmodified: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if tag.Modified() {
+		t.Error("Expected the tag to be unmodified after saving")
+	}
+}
+
+// TestReplaceLargeFrame checks that replacing a frame doesn't serialize it to detect the change.
+func TestReplaceLargeFrame(t *testing.T) {
+	tag := NewEmptyTag()
+	picture := PictureFrame{
+		Encoding:    EncodingUTF8,
+		MimeType:    MimeTypeJPEG,
+		PictureType: PTFrontCover,
+		Picture:     make([]byte, 1<<20),
+	}
+
+	tag.AddAttachedPicture(picture)
+
+	if allocs := testing.AllocsPerRun(10, func() { tag.AddAttachedPicture(picture) }); allocs > 5 {
+		t.Errorf("Expected at most 5 allocations, got %v", allocs)
+	}
+}
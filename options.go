@@ -1,5 +1,7 @@
 package id3v2
 
+import "log/slog"
+
 // Options defines the settings that influence how the tag is processed.
 type Options struct {
 	// Parse determines whether the tag should be parsed.
@@ -18,4 +20,181 @@ type Options struct {
 	// For instance, if you only need certain text frames, the library will skip parsing
 	// large or irrelevant frames like pictures or unknown frames.
 	ParseFrames []string
+
+	// MaxParsedFrames is the number of frames after which parsing stops,
+	// so probes that only need the first few frames don't walk hundreds of chapters in huge tags.
+	// Frames skipped because of ParseFrames aren't counted.
+	// If MaxParsedFrames is 0, all frames are parsed.
+	MaxParsedFrames int
+
+	// StopWhenParseFramesSeen determines whether parsing stops as soon as every frame listed
+	// in ParseFrames has been seen once, so only the first frame of each listed ID is parsed.
+	// Without it, parsing stops early only if ParseFrames lists no frames that can appear
+	// several times (e.g., pictures or chapters), as all of them are collected.
+	// This option only takes effect if ParseFrames isn't empty.
+	StopWhenParseFramesSeen bool
+
+	// RetainRawFrames determines whether the original header and body bytes of every parsed frame
+	// are kept and available via tag.RawFrames, e.g. for debugging and forensic tools.
+	// It doubles the memory used by the frames.
+	RetainRawFrames bool
+
+	// DiscardUnknownFrames determines whether frames that would be parsed as UnknownFrame
+	// (e.g., PRIV or GEOB) are dropped, so no memory is spent on their opaque bodies
+	// when only standard metadata is needed. The dropped frames are listed in tag.ParseStats.
+	// Note that saving the tag loses the dropped frames.
+	DiscardUnknownFrames bool
+
+	// ScanLimit is the number of leading bytes to search for the ID3 identifier
+	// if the file doesn't start with a tag.
+	// Some files start with garbage (broken downloads, RIFF fragments) before the tag.
+	// The offset of the found tag is available via tag.Offset,
+	// and Save drops the leading garbage, repairing the file.
+	// If ScanLimit is 0, the tag must be at the beginning of the file.
+	ScanLimit int
+
+	// TolerateWrongTagSize determines whether the size declared in the tag header is ignored
+	// when it doesn't match the frames, as some encoders write wrong sizes.
+	// Parsing goes on until the ID3 area clearly ends: at padding, an MPEG frame sync,
+	// garbage or the end of the file, instead of failing with ErrBodyOverflow or stopping early.
+	// The size of the tag is corrected to the actual end of the area, so Save replaces exactly it.
+	TolerateWrongTagSize bool
+
+	// Lenient determines whether malformed frames should be skipped instead of aborting parsing.
+	// Each skipped frame is described in tag.ParseWarnings.
+	// Language codes of comments and lyrics are coerced with NormalizeLanguageCode.
+	// Frame IDs written in lowercase (e.g., "Comm") or in the ID3v2.2 format padded with a space
+	// (e.g., "TAL ") are mapped to the canonical IDs ("COMM" and "TALB").
+	// After a frame whose size can't be trusted (e.g., it exceeds the tag), parsing goes on
+	// at the next plausible frame header, and the skipped bytes are reported in the warning.
+	// The extra zero byte written by older versions of this library after big-endian UTF-16 strings is skipped.
+	Lenient bool
+
+	// Forensic determines whether the byte ranges of the tag that couldn't be interpreted are recorded
+	// along with the reasons and available via tag.DamagedRanges, so repair tools can show exactly
+	// which part of a tag is damaged. It implies Lenient. The padding is read and checked for garbage,
+	// so parsing tags with large padding takes longer.
+	Forensic bool
+
+	// FixPictureMimeTypes determines whether the MIME types of parsed attached pictures
+	// should be verified against their data and fixed. See tag.FixPictureMimeTypes.
+	FixPictureMimeTypes bool
+
+	// ReadOnly determines whether the parsed tag is marked read-only, see tag.SetReadOnly,
+	// so tags parsed from untrusted uploads can't be modified by accident before verification.
+	ReadOnly bool
+
+	// Logger receives debug and warning events emitted while parsing,
+	// e.g. skipped frames, unknown frame IDs and encoding fallbacks.
+	// It helps to trace why a tag was parsed in an unexpected way.
+	// If Logger is nil, nothing is logged.
+	Logger *slog.Logger
+}
+
+// WriteOptions defines the settings that influence how the tag is serialized by WriteTo and Save.
+// Use tag.SetWriteOptions to apply them to a tag.
+type WriteOptions struct {
+	// Padding is the number of zero bytes appended after the last frame.
+	// Padding allows editors to grow the tag later without rewriting the whole file.
+	// Padding is written only if the tag has at least one frame.
+	Padding int
+
+	// AudioAlignment is the boundary in bytes, e.g. 4096, the audio data following the tag is aligned to,
+	// as some streaming servers and flash storage workflows want aligned reads.
+	// The padding is extended, so the size of the written tag is a multiple of AudioAlignment.
+	// It's ignored for tags with footers, as they have no padding. If AudioAlignment is 0, nothing is aligned.
+	AudioAlignment int
+
+	// FixPictureMimeTypes determines whether the MIME types of attached pictures
+	// should be verified against their data and fixed in the written tag. See tag.FixPictureMimeTypes.
+	// The frames stored in the tag aren't changed.
+	FixPictureMimeTypes bool
+
+	// SubstituteUnencodable determines whether characters that can't be represented
+	// in the encoding of their frame (e.g., Cyrillic letters in ISO-8859-1) are replaced with "?".
+	// Otherwise WriteTo and Save fail with ErrUnencodableText.
+	// In ID3v2.3 tags such frames are switched to UTF-16 first, unless StrictEncoding is set.
+	SubstituteUnencodable bool
+
+	// StrictEncoding disables the automatic switch to UTF-16 of frames of ID3v2.3 tags
+	// whose text can't be represented in ISO-8859-1, the default encoding of ID3v2.3.
+	// Without it, such frames are switched to UTF-16 in the written tag and a warning is logged,
+	// so Cyrillic or CJK text isn't corrupted. The frames stored in the tag aren't changed.
+	// With it, WriteTo and Save fail with ErrUnencodableText unless SubstituteUnencodable is set.
+	StrictEncoding bool
+
+	// Logger receives warning events emitted while writing, e.g. frames switched to UTF-16.
+	// If Logger is nil, nothing is logged.
+	Logger *slog.Logger
+
+	// UnicodeNormalization is the Unicode normalization form applied to all texts of the frames
+	// (values, descriptions, comments and lyrics), e.g. UnicodeFormNFC.
+	// Mixed normalization forms break duplicate detection and search in music servers,
+	// as "é" and "e" followed by a combining accent look the same but compare differently.
+	// The frames stored in the tag aren't changed, only the written tag is.
+	UnicodeNormalization UnicodeForm
+
+	// MaxTextLengths maps frame IDs or descriptions (e.g., "TIT2" or "Title") to the maximum lengths
+	// of their values in runes, for players that cut strings at 30 or 64 characters.
+	// Longer values are truncated in the written tag, see tag.TruncateTexts, and listed in tag.Truncations
+	// afterwards. The frames stored in the tag aren't changed.
+	MaxTextLengths map[string]int
+
+	// CompatibilityFrames determines whether the frames of both ID3v2.3 and ID3v2.4 should be written
+	// for values stored differently in them: the date in TDRC and in TYER, TDAT and TIME,
+	// and the involved people in TIPL and IPLS. This way an ID3v2.4 tag stays readable
+	// by software that understands only ID3v2.3. The frames stored in the tag aren't changed,
+	// only the written tag is. Use tag.RemoveCompatibilityFrames to remove the duplicates later.
+	CompatibilityFrames bool
+}
+
+// SaveOptions defines the settings that influence how the file is rewritten by SaveWithOptions.
+type SaveOptions struct {
+	// WriteID3v1 determines whether a trailing ID3v1.1 tag should be written after the music part.
+	// An existing ID3v1 tag is replaced. The values are taken from the ID3v2 frames
+	// and truncated to the ID3v1 field limits. This is useful for car stereos and old hardware
+	// that don't understand ID3v2.
+	WriteID3v1 bool
+
+	// StripTrailers determines whether ID3v1 and Lyrics3 (v1 and v2) blocks at the end of the file
+	// should be removed, so the file ends up with a single tag.
+	// If WriteID3v1 is also set, a fresh ID3v1 tag is written after stripping.
+	StripTrailers bool
+
+	// UpdateLength determines whether the length frame (TLEN) should be set
+	// to the duration of the audio stream in milliseconds before saving.
+	// The duration is computed with tag.AudioInfo, so stale TLEN values are replaced.
+	UpdateLength bool
+
+	// StampTaggingTime determines whether the tagging time frame (TDTG) should be set
+	// to the current UTC time if the tag was modified, see tag.Modified, giving archives an automatic audit trail.
+	// Saving an unchanged tag keeps the previous tagging time. TDTG is defined only in ID3v2.4,
+	// so ID3v2.3 tags aren't stamped.
+	StampTaggingTime bool
+
+	// SkipUnmodified determines whether saving is skipped if the tag wasn't modified since it was parsed,
+	// see tag.Modified, avoiding needless rewrites of whole files in batch jobs.
+	// The length frame is refreshed with UpdateLength before the check.
+	SkipUnmodified bool
+}
+
+// logWarn emits a warning event to the logger if it's set.
+func (opts WriteOptions) logWarn(msg string, args ...any) {
+	if opts.Logger != nil {
+		opts.Logger.Warn(msg, args...)
+	}
+}
+
+// logDebug emits a debug event to the logger if it's set.
+func (opts Options) logDebug(msg string, args ...any) {
+	if opts.Logger != nil {
+		opts.Logger.Debug(msg, args...)
+	}
+}
+
+// logWarn emits a warning event to the logger if it's set.
+func (opts Options) logWarn(msg string, args ...any) {
+	if opts.Logger != nil {
+		opts.Logger.Warn(msg, args...)
+	}
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 
 	"code.cloudfoundry.org/bytefmt"
 )
@@ -23,26 +24,109 @@ var (
 
 	// ErrBlankFrame is returned when a frame's ID or size is empty or invalid.
 	ErrBlankFrame = errors.New("id or size of frame are blank")
+
+	// ErrIncompleteTag is wrapped by errors of frames that failed to parse after the tag header was read.
+	// The tag returned along with such an error holds the frames parsed before the failure.
+	ErrIncompleteTag = errors.New("tag was parsed partially")
+
+	// ErrInvalidFrameID is reported in lenient mode for frames whose ID isn't 4 uppercase letters or digits.
+	ErrInvalidFrameID = errors.New("invalid frame ID")
+
+	// ErrTruncatedTag is wrapped along with ErrIncompleteTag when the file ends before the declared size
+	// of the tag, e.g. after an interrupted download. The tag holds the frames parsed completely.
+	ErrTruncatedTag = errors.New("file ends before end of tag")
 )
 
+// ParseWarning describes a frame skipped while parsing in lenient mode.
+type ParseWarning struct {
+	ID     string // The ID of the frame, empty if the frame header couldn't be read.
+	Offset int64  // The offset of the frame header in the file.
+	Err    error  // The reason why the frame was skipped.
+
+	// Skipped is the number of bytes starting at Offset skipped to find the next frame
+	// when the frame header couldn't be trusted, 0 if the frame was skipped by its size.
+	Skipped int64
+}
+
+// Error returns the warning formatted as an error message.
+func (pw ParseWarning) Error() string {
+	return fmt.Sprintf("frame %q at offset %d: %v", pw.ID, pw.Offset, pw.Err)
+}
+
+// Unwrap returns the reason why the frame was skipped.
+func (pw ParseWarning) Unwrap() error {
+	return pw.Err
+}
+
+// ParseStats describes what was left out while parsing.
+type ParseStats struct {
+	DiscardedFrames []DiscardedFrame // The unknown frames dropped with Options.DiscardUnknownFrames.
+}
+
+// DiscardedFrame describes a frame dropped while parsing.
+type DiscardedFrame struct {
+	ID     string // The ID of the frame.
+	Size   int64  // The size of the frame body in bytes.
+	Offset int64  // The offset of the frame header in the file.
+}
+
+// RawFrame holds the original bytes of a parsed frame, see Options.RetainRawFrames.
+type RawFrame struct {
+	ID     string // The ID of the frame.
+	Offset int64  // The offset of the frame header in the file.
+	Header []byte // The frame header as read from the file.
+	Body   []byte // The frame body as read from the file.
+	Frame  Framer // The frame parsed from the body.
+}
+
 // frameHeader represents the header of an ID3v2 frame, containing the frame ID and body size.
 type frameHeader struct {
-	ID       string // The 4-character frame ID (e.g., "TIT2" for title).
-	BodySize int64  // The size of the frame's body in bytes.
+	ID          string // The 4-character frame ID (e.g., "TIT2" for title).
+	BodySize    int64  // The size of the frame's body in bytes.
+	FormatFlags byte   // The format flags of the frame (e.g., compression or unsynchronisation).
 }
 
 // parse reads the ID3v2 tag from the provided reader and parses it according to the given options.
 // If the reader is smaller than expected, it returns ErrSmallHeaderSize.
 func (tag *Tag) parse(rd io.Reader, opts Options) error {
+	return tag.parseWith(rd, opts, nil)
+}
+
+// parseWith parses the tag like parse, passing every parsed frame to emit instead of adding it to the tag,
+// unless emit is nil.
+func (tag *Tag) parseWith(rd io.Reader, opts Options, emit func(id string, frame Framer) error) error {
 	if rd == nil {
 		return errors.New("rd is nil") // Ensure the reader is not nil.
 	}
 
+	// The tag is marked read-only once it's filled.
+	defer func() { tag.readOnly = opts.ReadOnly }()
+
+	// Damaged ranges are found by skipping what can't be parsed instead of failing.
+	if opts.Forensic {
+		opts.Lenient = true
+	}
+
+	// DSF and DFF files store the tag at the location defined by the container.
+	container, src, offset, err := detectContainer(rd)
+	if err != nil {
+		return fmt.Errorf("error by reading container: %w", err)
+	}
+
+	// Look for the tag after leading junk bytes if requested.
+	if container == ContainerMP3 && opts.ScanLimit > 0 {
+		src, offset, err = scanForTag(rd, opts.ScanLimit)
+		if err != nil {
+			return fmt.Errorf("error by scanning for tag: %w", err)
+		}
+	}
+
 	// Parse the tag header to get the version and size of the frames.
-	header, err := parseHeader(rd)
+	header, err := parseHeader(src)
 	if errors.Is(err, ErrNoTag) || errors.Is(err, io.EOF) {
 		// If there's no tag or EOF, initialize an empty tag with default settings.
 		tag.init(rd, 0, 4)
+		tag.container = container
 
 		return nil
 	}
@@ -58,14 +142,86 @@ func (tag *Tag) parse(rd io.Reader, opts Options) error {
 
 	// Initialize the tag with the parsed header information.
 	tag.init(rd, tagHeaderSize+header.FramesSize, header.Version)
+	tag.header = header.info()
+	tag.headerFlags = HeaderFlags{
+		Unsynchronisation: tag.header.Unsynchronisation,
+		Footer:            tag.header.Footer,
+		Experimental:      tag.header.Experimental,
+	}
+	tag.offset = offset
+	tag.container = container
+
+	// The footer follows the frames, so it's replaced along with them on save.
+	defer func() {
+		if tag.header.Footer {
+			tag.originalSize += tagFooterSize
+		}
+	}()
+
+	// ID3v2.3 tags are unsynchronised as a whole. The removed bytes are read as padding,
+	// so the frames keep their offsets within the declared size.
+	if tag.header.Unsynchronisation && tag.version == 3 {
+		src = &synchronisingReader{r: io.LimitReader(src, header.FramesSize), fill: true}
+	}
 
 	// If parsing is disabled, return early.
 	if !opts.Parse {
 		return nil
 	}
 
-	// Parse the frames within the tag.
-	return tag.parseFrames(opts)
+	// Parse the frames within the tag, keeping the ones parsed before a failure.
+	// The parsed frames are what the file holds, so they aren't modifications.
+	err = tag.parseFrames(src, opts, emit)
+	tag.modified = false
+
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrIncompleteTag, err)
+	}
+
+	if opts.FixPictureMimeTypes {
+		tag.FixPictureMimeTypes()
+	}
+
+	return nil
+}
+
+// scanForTag searches the first `limit` bytes of the reader for the ID3 identifier
+// followed by a plausible tag header.
+// It returns a reader that starts at the found tag and the offset of the tag.
+// If no tag is found, the returned reader starts at the original position and the offset is 0.
+func scanForTag(rd io.Reader, limit int) (io.Reader, int64, error) {
+	buf := make([]byte, limit+tagHeaderSize)
+
+	n, err := io.ReadFull(rd, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, 0, err
+	}
+
+	buf = buf[:n]
+
+	for i := 0; i+tagHeaderSize <= len(buf); i++ {
+		if isPlausibleTagHeader(buf[i : i+tagHeaderSize]) {
+			return io.MultiReader(bytes.NewReader(buf[i:]), rd), int64(i), nil
+		}
+	}
+
+	return io.MultiReader(bytes.NewReader(buf), rd), 0, nil
+}
+
+// isPlausibleTagHeader checks whether the data looks like an ID3v2 tag header:
+// the ID3 identifier, a version and a revision below 0xFF and a synchsafe size.
+func isPlausibleTagHeader(data []byte) bool {
+	if !isID3Tag(data[0:3]) || data[3] == 0xFF || data[4] == 0xFF {
+		return false
+	}
+
+	for _, b := range data[6:tagHeaderSize] {
+		if b >= 0x80 {
+			return false
+		}
+	}
+
+	return true
 }
 
 // init initializes the tag with the provided reader, size, and version.
@@ -74,18 +230,32 @@ func (tag *Tag) init(rd io.Reader, originalSize int64, version byte) {
 	tag.DeleteAllFrames() // Clear any existing frames.
 
 	tag.reader = rd
+	tag.fsys = nil
+	tag.offset = 0
+	tag.container = ContainerMP3
 	tag.originalSize = originalSize
 	tag.version = version
+	tag.header = Info{Version: version}
+	tag.headerFlags = HeaderFlags{}
+	tag.parseWarnings = nil
+	tag.parseStats = ParseStats{}
+	tag.rawFrames = nil
+	tag.damagedRanges = nil
+	tag.nonSynchsafeSizes = false
+	tag.modified = false
+	tag.readOnly = false
 	tag.setDefaultEncodingBasedOnVersion(version) // Set encoding based on version.
 }
 
-// parseFrames parses the frames within the tag according to the provided options.
-func (tag *Tag) parseFrames(opts Options) error {
+// parseFrames parses the frames read from `rd` according to the provided options.
+// The frames are passed to emit if it's not nil, otherwise they're added to the tag.
+func (tag *Tag) parseFrames(rd io.Reader, opts Options, emit func(id string, frame Framer) error) error {
 	framesSize := tag.originalSize - tagHeaderSize // Calculate the remaining size for frames.
 
 	// Create a map of frame IDs to parse based on the provided options.
 	parseableIDs := tag.makeIDsFromDescriptions(opts.ParseFrames)
 	isParseFramesProvided := len(opts.ParseFrames) > 0
+	parsedFrames := 0
 
 	// Determine if the tag uses synch-safe sizes (ID3v2.4 feature).
 	synchSafe := tag.Version() == 4
@@ -94,56 +264,249 @@ func (tag *Tag) parseFrames(opts Options) error {
 	br := getBufReader(nil)
 	defer putBufReader(br)
 
+	br.legacyUTF16 = opts.Lenient
+
 	buf := getByteSlice(defaultBufferSize)
 	defer putByteSlice(buf)
 
 	// Iterate through the frames until the remaining size is exhausted.
-	for framesSize > 0 {
-		header, err := parseFrameHeader(buf, tag.reader, synchSafe)
-		if errors.Is(err, io.EOF) || errors.Is(err, ErrBlankFrame) || errors.Is(err, ErrInvalidSizeFormat) {
-			break // Stop parsing if we hit EOF or encounter an invalid frame.
+	// With a wrong declared tag size, parsing goes on until the ID3 area clearly ends.
+	for framesSize > 0 || opts.TolerateWrongTagSize {
+		// The offset of the frame header in the file, used by parse warnings.
+		frameOffset := tag.offset + tag.originalSize - framesSize
+
+		header, err := parseFrameHeader(buf, rd, synchSafe)
+		if opts.TolerateWrongTagSize && tag.endsTagArea(rd, buf, header, err, frameOffset, opts) {
+			break
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return ErrTruncatedTag // The file ends before the declared end of the tag.
+		}
+
+		if errors.Is(err, ErrBlankFrame) {
+			if opts.Forensic {
+				if err = tag.checkPadding(rd, buf, frameOffset, framesSize); err != nil {
+					return err
+				}
+			}
+
+			break // Stop parsing if we hit padding.
+		}
+
+		// Some taggers write ID3v2.3 sizes in ID3v2.4 tags. If the size only makes sense
+		// as a plain integer, read all following sizes that way and let Repair report it.
+		if errors.Is(err, ErrInvalidSizeFormat) && synchSafe {
+			unsafeHeader, unsafeErr := parseFrameHeaderBytes(buf[:frameHeaderSize], false)
+			if unsafeErr == nil && frameHeaderSize+unsafeHeader.BodySize <= framesSize {
+				header, err = unsafeHeader, nil
+				synchSafe = false
+				tag.nonSynchsafeSizes = true
+
+				opts.logWarn("ID3v2.4 tag has non-synchsafe frame sizes", "id", header.ID, "offset", frameOffset)
+			}
+		}
+
+		if errors.Is(err, ErrInvalidSizeFormat) {
+			if !opts.Lenient {
+				opts.logWarn("stopped parsing at frame with invalid size", "offset", frameOffset)
+
+				break // The position of the next frame is unknown.
+			}
+
+			// Look for the next frame, as the position of the next frame is unknown.
+			var skipped int64
+
+			rd, skipped, err = resynchronize(rd, buf[:frameHeaderSize], framesSize, synchSafe)
+			if err != nil {
+				return err
+			}
+
+			tag.addResyncWarning(opts, header.ID, frameOffset, ErrInvalidSizeFormat, skipped)
+
+			if framesSize -= skipped; skipped == 0 || framesSize <= 0 {
+				break
+			}
+
+			continue
 		}
 
 		if err != nil {
 			return err
 		}
 
+		// Sizes that read the same either way can't tell the formats apart, others are verified
+		// by checking where the next frame starts.
+		if synchSafe {
+			var plainSizes bool
+
+			rd, header, plainSizes, err = resolveSizeFormat(rd, buf[:frameHeaderSize], header, framesSize)
+			if err != nil {
+				return err
+			}
+
+			if plainSizes {
+				synchSafe = false
+				tag.nonSynchsafeSizes = true
+
+				opts.logWarn("ID3v2.4 tag has non-synchsafe frame sizes", "id", header.ID, "offset", frameOffset)
+			}
+		}
+
 		id, bodySize := header.ID, header.BodySize
 
+		// Accept IDs written in lowercase or in the ID3v2.2 format in lenient mode.
+		if opts.Lenient && !isValidFrameID(id) {
+			if normalized, ok := normalizeFrameID(id); ok {
+				opts.logWarn("normalized malformed frame ID", "id", id, "normalized", normalized, "offset", frameOffset)
+
+				id = normalized
+			}
+		}
+
 		// Update the remaining size after accounting for the current frame.
 		framesSize -= frameHeaderSize + bodySize
-		if framesSize < 0 {
-			return ErrBodyOverflow // Frame exceeds the remaining tag size.
+		if framesSize < 0 && !opts.TolerateWrongTagSize {
+			if !opts.Lenient {
+				return ErrBodyOverflow // Frame exceeds the remaining tag size.
+			}
+
+			// The size can't be trusted, so look for the next frame.
+			framesSize += frameHeaderSize + bodySize
+
+			var skipped int64
+
+			rd, skipped, err = resynchronize(rd, buf[:frameHeaderSize], framesSize, synchSafe)
+			if err != nil {
+				return err
+			}
+
+			tag.addResyncWarning(opts, id, frameOffset, ErrBodyOverflow, skipped)
+
+			if framesSize -= skipped; skipped == 0 || framesSize <= 0 {
+				break
+			}
+
+			continue
 		}
 
 		// Create a limited reader for the frame's body.
-		bodyReader := getLimitedReader(tag.reader, bodySize)
+		bodyReader := getLimitedReader(rd, bodySize)
 		defer putLimitedReader(bodyReader)
 
 		// Skip frames that are not in the list of frames to parse.
 		if isParseFramesProvided && !parseableIDs[id] {
-			if err = skipReaderBuf(bodyReader, buf); err != nil {
+			opts.logDebug("skipped frame not listed in ParseFrames", "id", id, "offset", frameOffset)
+
+			if err = skipBody(bodyReader, buf); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// Skip frames with garbage instead of an ID in lenient mode.
+		if opts.Lenient && !isValidFrameID(id) {
+			tag.addParseWarning(opts, id, frameOffset, frameHeaderSize+bodySize, ErrInvalidFrameID)
+
+			if err = skipBody(bodyReader, buf); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// Drop frames no parser knows about without reading their bodies into memory.
+		if opts.DiscardUnknownFrames && !isKnownFrameID(id) {
+			opts.logDebug("discarded frame with unknown ID", "id", id, "offset", frameOffset, "size", bodySize)
+
+			tag.parseStats.DiscardedFrames = append(tag.parseStats.DiscardedFrames,
+				DiscardedFrame{ID: id, Size: bodySize, Offset: frameOffset})
+
+			if err = skipBody(bodyReader, buf); err != nil {
 				return err
 			}
 
 			continue
 		}
 
-		// Reset the buffered reader to read the frame's body.
-		br.Reset(bodyReader)
+		// Reset the buffered reader to read the frame's body, keeping a copy of it if requested.
+		var (
+			raw  *RawFrame
+			body io.Reader = bodyReader
+		)
+
+		if opts.RetainRawFrames {
+			raw = &RawFrame{ID: id, Offset: frameOffset, Header: bytes.Clone(buf[:frameHeaderSize])}
+
+			// A truncated body is parsed as far as it goes, like without the option.
+			raw.Body, _ = io.ReadAll(bodyReader)
+			body = bytes.NewReader(raw.Body)
+		}
+
+		// ID3v2.4 frames may be unsynchronised and start with a data length indicator.
+		if tag.version == 4 {
+			body = frameBody(body, header.FormatFlags, tag.header.Unsynchronisation)
+		}
+
+		br.Reset(body)
+
+		if opts.Logger != nil && hasEncodingByte(id) {
+			if key, peekErr := br.buf.Peek(1); peekErr == nil && key[0] > EncodingUTF8.Key {
+				opts.logWarn("invalid text encoding, falling back to UTF-8", "id", id, "offset", frameOffset, "encoding", key[0])
+			}
+		}
 
 		// Parse the frame's body based on its ID.
 		frame, err := parseFrameBody(id, br, tag.version)
+
+		// Discard the rest of the body, so the next frame header can be read.
+		// A body cut short by the end of the file isn't kept.
+		if skipErr := skipBody(bodyReader, buf); skipErr != nil {
+			return skipErr
+		}
+
 		if err != nil && !errors.Is(err, io.EOF) {
-			return err
+			if !opts.Lenient {
+				return err
+			}
+
+			tag.addParseWarning(opts, id, frameOffset, frameHeaderSize+bodySize, err)
+
+			continue
 		}
 
-		// Add the parsed frame to the tag.
-		tag.AddFrame(id, frame)
+		// Coerce language codes like "en" or "XXX" written by lenient taggers.
+		if opts.Lenient {
+			frame = normalizeFrameLanguage(frame)
+		}
+
+		if _, ok := frame.(UnknownFrame); ok {
+			opts.logDebug("kept frame with unknown ID as raw data", "id", id, "offset", frameOffset)
+		}
+
+		// Add the parsed frame to the tag or pass it to the callback.
+		if emit == nil {
+			tag.AddFrame(id, frame)
+		} else if emitErr := emit(id, frame); emitErr != nil {
+			return emitErr
+		}
 
-		// If parsing specific frames and this frame is not part of a sequence,
-		// remove it from the list of frames to parse.
-		if isParseFramesProvided && !mustFrameBeInSequence(id) {
+		if raw != nil {
+			raw.Frame = frame
+			tag.rawFrames = append(tag.rawFrames, *raw)
+		}
+
+		parsedFrames++
+		if opts.MaxParsedFrames > 0 && parsedFrames >= opts.MaxParsedFrames {
+			opts.logDebug("stopped parsing after MaxParsedFrames frames", "offset", frameOffset)
+
+			break
+		}
+
+		// If parsing specific frames and this frame is not part of a sequence
+		// (or the first one is enough), remove it from the list of frames to parse.
+		if isParseFramesProvided && (opts.StopWhenParseFramesSeen || !mustFrameBeInSequence(id)) {
 			delete(parseableIDs, id)
 
 			// If no more frames to parse, stop.
@@ -160,6 +523,154 @@ func (tag *Tag) parseFrames(opts Options) error {
 	return nil
 }
 
+// endsTagArea reports whether the frame header read at the offset marks the end of the ID3 area,
+// which is detected by padding, an MPEG frame sync, garbage or the end of the file
+// instead of the declared tag size. If the area doesn't end at the declared size,
+// the size of the tag is corrected, so Save replaces exactly the ID3 area.
+// Frame headers before the declared end are left to the regular checks.
+func (tag *Tag) endsTagArea(rd io.Reader, buf []byte, header frameHeader, err error, offset int64, opts Options) bool {
+	declaredEnd := tag.offset + tag.originalSize
+	data := buf[:frameHeaderSize]
+	end := offset
+
+	switch {
+	case err == nil && (isValidFrameID(header.ID) || opts.Lenient && isNormalizableFrameID(header.ID)):
+		if offset >= declaredEnd {
+			opts.logWarn("frame found after the declared end of the tag", "id", header.ID, "offset", offset)
+		}
+
+		return false
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		// The file ends right after the last frame.
+	case errors.Is(err, ErrBlankFrame) && bytes.Count(data, []byte{0}) == len(data):
+		// Padding continues until the first non-zero byte, which is either audio or garbage.
+		end = offset + frameHeaderSize + countZeroBytes(rd, buf)
+		if end < declaredEnd && !isMPEGSync(buf[:2]) {
+			end = declaredEnd
+		}
+	case offset < declaredEnd && !isMPEGSync(data):
+		return false
+	}
+
+	if end != declaredEnd {
+		opts.logWarn("declared tag size doesn't match the frames, corrected",
+			"declared", tag.originalSize, "actual", end-tag.offset)
+
+		tag.originalSize = end - tag.offset
+	}
+
+	return true
+}
+
+// countZeroBytes reads the reader until the first non-zero byte and returns the number of zero bytes.
+// The non-zero byte and the one after it are left at the beginning of buf.
+func countZeroBytes(rd io.Reader, buf []byte) int64 {
+	var count int64
+
+	for {
+		n, err := io.ReadFull(rd, buf)
+		if i := slices.IndexFunc(buf[:n], func(b byte) bool { return b != 0 }); i >= 0 {
+			// Make the following byte available for the MPEG sync check.
+			copy(buf, buf[i:n])
+
+			if n-i < 2 {
+				if _, err = io.ReadFull(rd, buf[1:2]); err != nil {
+					buf[1] = 0
+				}
+			}
+
+			return count + int64(i)
+		}
+
+		count += int64(n)
+
+		if err != nil {
+			clear(buf[:2])
+
+			return count
+		}
+	}
+}
+
+// isMPEGSync reports whether the data starts with the frame sync of an MPEG audio frame.
+func isMPEGSync(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0xFF && data[1]&0xE0 == 0xE0
+}
+
+// addResyncWarning records and logs a frame header that couldn't be trusted in lenient mode
+// and the number of bytes skipped to find the next frame.
+func (tag *Tag) addResyncWarning(opts Options, id string, offset int64, err error, skipped int64) {
+	opts.logWarn("skipped bytes to find the next frame", "id", id, "offset", offset, "skipped", skipped, "error", err)
+
+	tag.parseWarnings = append(tag.parseWarnings, ParseWarning{ID: id, Offset: offset, Err: err, Skipped: skipped})
+
+	if opts.Forensic {
+		tag.damagedRanges = append(tag.damagedRanges, DamagedRange{Offset: offset, Size: skipped, Err: err})
+	}
+}
+
+// resynchronize searches the rest of the tag area for the next plausible frame header:
+// a valid frame ID followed by a valid size that fits in the area.
+// headerBytes is the untrusted frame header and remaining is the size of the area starting with it.
+// It returns a reader positioned at the found frame header and the number of skipped bytes.
+// If no frame is found, the whole area is skipped.
+func resynchronize(rd io.Reader, headerBytes []byte, remaining int64, synchSafe bool) (io.Reader, int64, error) {
+	area := make([]byte, max(remaining, int64(len(headerBytes))))
+	copy(area, headerBytes)
+
+	n, err := io.ReadFull(rd, area[len(headerBytes):])
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return rd, 0, err
+	}
+
+	area = area[:len(headerBytes)+n]
+
+	for p := 1; p+frameHeaderSize <= len(area); p++ {
+		if !isValidFrameID(string(area[p : p+4])) {
+			continue
+		}
+
+		header, err := parseFrameHeaderBytes(area[p:p+frameHeaderSize], synchSafe) //nolint:govet // Shadowing is intended.
+		if err != nil || int64(p+frameHeaderSize)+header.BodySize > int64(len(area)) {
+			continue
+		}
+
+		return io.MultiReader(bytes.NewReader(area[p:]), rd), int64(p), nil
+	}
+
+	return rd, int64(len(area)), nil
+}
+
+// addParseWarning records and logs a frame of the size, including its header, skipped in lenient mode.
+func (tag *Tag) addParseWarning(opts Options, id string, offset, size int64, err error) {
+	opts.logWarn("skipped malformed frame", "id", id, "offset", offset, "error", err)
+
+	tag.parseWarnings = append(tag.parseWarnings, ParseWarning{ID: id, Offset: offset, Err: err})
+
+	if opts.Forensic {
+		tag.damagedRanges = append(tag.damagedRanges, DamagedRange{Offset: offset, Size: size, Err: err})
+	}
+}
+
+// ParseWarnings returns the frames skipped while parsing with Options.Lenient, in the order of occurrence.
+// It returns nil if the tag was parsed without problems or lenient mode wasn't enabled.
+func (tag *Tag) ParseWarnings() []ParseWarning {
+	return tag.parseWarnings
+}
+
+// RawFrames returns the original header and body bytes of the parsed frames in the order of occurrence,
+// if the tag was parsed with Options.RetainRawFrames. Forensic tools can compare them
+// byte by byte with the re-serialized frames. The bytes are those of the file,
+// i.e. before the frames were modified.
+func (tag *Tag) RawFrames() []RawFrame {
+	return tag.rawFrames
+}
+
+// ParseStats returns what was left out while parsing, e.g. the frames dropped with Options.DiscardUnknownFrames.
+func (tag *Tag) ParseStats() ParseStats {
+	return tag.parseStats
+}
+
 // makeIDsFromDescriptions converts a list of frame descriptions into a map of frame IDs.
 func (tag *Tag) makeIDsFromDescriptions(parseFrames []string) map[string]bool {
 	ids := make(map[string]bool, len(parseFrames))
@@ -181,14 +692,21 @@ func parseFrameHeader(buf []byte, rd io.Reader, synchSafe bool) (frameHeader, er
 
 	// Read the frame header into the buffer.
 	fhBuf := buf[:frameHeaderSize]
-	if _, err := rd.Read(fhBuf); err != nil {
+	if _, err := io.ReadFull(rd, fhBuf); err != nil {
 		return header, err
 	}
 
-	id := fhBuf[:4] // Extract the frame ID.
+	return parseFrameHeaderBytes(fhBuf, synchSafe)
+}
+
+// parseFrameHeaderBytes parses the frame header stored in data.
+func parseFrameHeaderBytes(data []byte, synchSafe bool) (frameHeader, error) {
+	var header frameHeader
+
+	id := data[:4] // Extract the frame ID.
 
 	// Parse the frame's body size, considering synch-safe encoding if necessary.
-	bodySize, err := parseSize(fhBuf[4:8], synchSafe)
+	bodySize, err := parseSize(data[4:8], synchSafe)
 	if err != nil {
 		return header, err
 	}
@@ -200,10 +718,143 @@ func parseFrameHeader(buf []byte, rd io.Reader, synchSafe bool) (frameHeader, er
 
 	header.ID = string(id)
 	header.BodySize = bodySize
+	header.FormatFlags = data[9]
 
 	return header, nil
 }
 
+// resolveSizeFormat checks whether the frame with a synchsafe size is followed by a plausible frame start,
+// as old iTunes versions wrote plain integer sizes in ID3v2.4 tags. If it isn't, but the plain integer
+// interpretation of the size is, it returns the header with the plain size and true.
+// Only the bytes at the ends of both candidate bodies are read, the reader stays positioned at the frame body.
+func resolveSizeFormat(
+	rd io.Reader, headerBytes []byte, header frameHeader, framesSize int64,
+) (io.Reader, frameHeader, bool, error) {
+	plain, err := parseFrameHeaderBytes(headerBytes, false)
+	if err != nil || plain.BodySize == header.BodySize || frameHeaderSize+plain.BodySize > framesSize {
+		return rd, header, false, nil
+	}
+
+	rs, ok := rd.(io.ReadSeeker)
+	if !ok {
+		return resolveSizeFormatBuffered(rd, header, plain, framesSize)
+	}
+
+	// The last byte of the body is read as well to tell a body cut off by the end of the file
+	// from a body ending right at it.
+	ahead := make([]byte, frameHeaderSize+1)
+
+	n, err := readAhead(rs, header.BodySize-1, ahead)
+	if err != nil {
+		return rd, header, false, err
+	}
+
+	if n == 0 || isPlausibleFrameStart(ahead[1:n], framesSize-frameHeaderSize-header.BodySize) {
+		return rd, header, false, nil
+	}
+
+	if n, err = readAhead(rs, plain.BodySize-1, ahead); err != nil {
+		return rd, header, false, err
+	}
+
+	if n > 0 && isPlausibleFrameStart(ahead[1:n], framesSize-frameHeaderSize-plain.BodySize) {
+		return rd, plain, true, nil
+	}
+
+	return rd, header, false, nil
+}
+
+// readAhead reads up to len(p) bytes starting `offset` bytes after the current position of rs
+// and seeks back, so the position doesn't change. It returns the number of bytes read.
+func readAhead(rs io.ReadSeeker, offset int64, p []byte) (int, error) {
+	current, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err = rs.Seek(offset, io.SeekCurrent); err != nil {
+		return 0, err
+	}
+
+	n, err := io.ReadFull(rs, p)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return 0, err
+	}
+
+	if _, err = rs.Seek(current, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// resolveSizeFormatBuffered resolves the size format like resolveSizeFormat for readers that can't seek
+// (e.g., network streams). The bytes read ahead are put back, so the returned reader is positioned
+// at the frame body.
+func resolveSizeFormatBuffered(
+	rd io.Reader, header, plain frameHeader, framesSize int64,
+) (io.Reader, frameHeader, bool, error) {
+	// Read the body and the following frame header as if the size was synchsafe.
+	ahead := make([]byte, header.BodySize+frameHeaderSize)
+
+	n, err := io.ReadFull(rd, ahead)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return rd, header, false, err
+	}
+
+	ahead = ahead[:n]
+
+	if n < int(header.BodySize) ||
+		isPlausibleFrameStart(ahead[header.BodySize:], framesSize-frameHeaderSize-header.BodySize) {
+		return io.MultiReader(bytes.NewReader(ahead), rd), header, false, nil
+	}
+
+	// Read up to the frame header following the plain integer size.
+	rest := make([]byte, plain.BodySize+frameHeaderSize-int64(n))
+
+	m, err := io.ReadFull(rd, rest)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return rd, header, false, err
+	}
+
+	ahead = append(ahead, rest[:m]...)
+	rd = io.MultiReader(bytes.NewReader(ahead), rd)
+
+	if len(ahead) >= int(plain.BodySize) &&
+		isPlausibleFrameStart(ahead[plain.BodySize:], framesSize-frameHeaderSize-plain.BodySize) {
+		return rd, plain, true, nil
+	}
+
+	return rd, header, false, nil
+}
+
+// isPlausibleFrameStart reports whether the data following a frame looks like what may follow it:
+// a frame header, padding, the end of the tag (remaining is 0) or the end of the file.
+func isPlausibleFrameStart(data []byte, remaining int64) bool {
+	switch {
+	case remaining < 0:
+		return false
+	case remaining == 0, len(data) == 0, data[0] == 0:
+		return true
+	default:
+		return len(data) >= 4 && isValidFrameID(string(data[:4]))
+	}
+}
+
+// skipBody discards the rest of the frame body.
+// It returns ErrTruncatedTag if the file ends before the end of the body.
+func skipBody(body *io.LimitedReader, buf []byte) error {
+	if err := skipReaderBuf(body, buf); err != nil {
+		return err
+	}
+
+	if body.N > 0 {
+		return ErrTruncatedTag
+	}
+
+	return nil
+}
+
 // skipReaderBuf reads and discards data from the reader until EOF.
 func skipReaderBuf(rd io.Reader, buf []byte) error {
 	for {
@@ -222,6 +873,11 @@ func skipReaderBuf(rd io.Reader, buf []byte) error {
 
 // parseFrameBody parses the body of a frame based on its ID.
 func parseFrameBody(id string, br *bufferedReader, version byte) (Framer, error) {
+	// Registered parsers take precedence over the built-in ones.
+	if parseFunc, exists := customParser(id); exists {
+		return parseFunc(br, version)
+	}
+
 	// Handle text frames (frames starting with 'T').
 	if id[0] == 'T' && id != UserDefinedTextFrameID {
 		return parseTextFrame(br)
@@ -235,3 +891,29 @@ func parseFrameBody(id string, br *bufferedReader, version byte) (Framer, error)
 	// Fall back to parsing unknown frames.
 	return parseUnknownFrame(br)
 }
+
+// isKnownFrameID reports whether the frame with the given ID is parsed into a typed frame
+// rather than an UnknownFrame.
+func isKnownFrameID(id string) bool {
+	if id[0] == 'T' {
+		return true
+	}
+
+	if _, exists := parsers[id]; exists {
+		return true
+	}
+
+	_, exists := customParser(id)
+
+	return exists
+}
+
+// hasEncodingByte reports whether the body of the frame with the given ID starts with a text encoding byte.
+func hasEncodingByte(id string) bool {
+	switch id {
+	case "APIC", "COMM", "SYLT", "USLT":
+		return true
+	default:
+		return id[0] == 'T'
+	}
+}
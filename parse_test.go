@@ -4,6 +4,11 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -76,6 +81,107 @@ func TestParseOptionsParseFrames(t *testing.T) {
 	}
 }
 
+func TestParseOptionsEarlyStop(t *testing.T) {
+	t.Parallel()
+
+	source := NewEmptyTag()
+	source.SetTitle("Title")
+
+	for i := range 10 {
+		source.AddChapterFrame(ChapterFrame{
+			ElementID:   "chp" + strconv.Itoa(i),
+			StartOffset: IgnoredOffset,
+			EndOffset:   IgnoredOffset,
+		})
+	}
+
+	data, err := source.Bytes()
+	if err != nil {
+		t.Fatalf("Error serializing tag: %v", err)
+	}
+
+	tag, err := ParseReader(bytes.NewReader(data), Options{Parse: true, MaxParsedFrames: 3})
+	if err != nil {
+		t.Fatalf("Error parsing tag: %v", err)
+	}
+
+	if tag.Count() != 3 {
+		t.Errorf("Expected %v frames, got %v", 3, tag.Count())
+	}
+
+	opts := Options{Parse: true, ParseFrames: []string{"Chapters", "Title"}, StopWhenParseFramesSeen: true}
+
+	if tag, err = ParseReader(bytes.NewReader(data), opts); err != nil {
+		t.Fatalf("Error parsing tag: %v", err)
+	}
+
+	if chapters := len(tag.GetFrames(tag.CommonID("Chapters"))); chapters != 1 || tag.Title() != "Title" {
+		t.Errorf("Expected 1 chapter and the title, got %v chapters and title %q", chapters, tag.Title())
+	}
+}
+
+func TestParseOptionsDiscardUnknownFrames(t *testing.T) {
+	t.Parallel()
+
+	source := NewEmptyTag()
+	source.SetTitle("Title")
+	source.AddFrame(FramePRIV, UnknownFrame{Body: bytes.Repeat([]byte{1}, 100)})
+
+	data, err := source.Bytes()
+	if err != nil {
+		t.Fatalf("Error serializing tag: %v", err)
+	}
+
+	tag, err := ParseReader(bytes.NewReader(data), Options{Parse: true, DiscardUnknownFrames: true})
+	if err != nil {
+		t.Fatalf("Error parsing tag: %v", err)
+	}
+
+	if tag.Count() != 1 || tag.Title() != "Title" {
+		t.Errorf("Expected only the title frame, got %v", tag.AllFrames())
+	}
+
+	discarded := tag.ParseStats().DiscardedFrames
+	if len(discarded) != 1 || discarded[0].ID != FramePRIV || discarded[0].Size != 100 {
+		t.Errorf("Expected the discarded PRIV frame of 100 bytes, got %+v", discarded)
+	}
+}
+
+func TestParseOptionsRetainRawFrames(t *testing.T) {
+	t.Parallel()
+
+	source := NewEmptyTag()
+	source.SetTitle("Title")
+
+	data, err := source.Bytes()
+	if err != nil {
+		t.Fatalf("Error serializing tag: %v", err)
+	}
+
+	tag, err := ParseReader(bytes.NewReader(data), Options{Parse: true, RetainRawFrames: true})
+	if err != nil {
+		t.Fatalf("Error parsing tag: %v", err)
+	}
+
+	raw := tag.RawFrames()
+	if len(raw) != 1 {
+		t.Fatalf("Expected 1 raw frame, got %v", len(raw))
+	}
+
+	expected := data[tagHeaderSize:]
+	if !bytes.Equal(append(raw[0].Header, raw[0].Body...), expected) {
+		t.Errorf("Expected raw bytes %v, got %v and %v", expected, raw[0].Header, raw[0].Body)
+	}
+
+	if tf, ok := raw[0].Frame.(TextFrame); !ok || tf.Text != "Title" || raw[0].ID != FrameTIT2 {
+		t.Errorf("Expected the parsed title frame, got %v %#v", raw[0].ID, raw[0].Frame)
+	}
+
+	if tag, err = ParseReader(bytes.NewReader(data), Options{Parse: true}); err != nil || tag.RawFrames() != nil {
+		t.Errorf("Expected no raw frames without the option, got %v (error: %v)", tag.RawFrames(), err)
+	}
+}
+
 // TestParseOptionsParseFramesWithSequenceFrames checks,
 // if tag.parseAllFrames() will correctly parse frames, that set in Options.ParseFrames
 // and may be more than one in tag.
@@ -172,7 +278,7 @@ func TestParseInvalidFrameSize(t *testing.T) {
 	bw := newBufferedWriter(buf)
 
 	// Write tag header.
-	err := writeTagHeader(bw, tagHeaderSize+16, 4)
+	err := writeTagHeader(bw, tagHeaderSize+16, 4, 0, 0)
 	if err != nil {
 		t.Fatal("Error while writing tag header:", err)
 	}
@@ -643,3 +749,444 @@ func compareTwoBytes(actual, expected byte) error {
 
 	return nil
 }
+
+func TestParseWithScanLimit(t *testing.T) {
+	tmpFile, err := prepareTestFile("junk-*.mp3")
+	if err != nil {
+		t.Fatal("Error while preparing test file:", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	tmpFile.Close()
+
+	original, err := os.ReadFile(tmpFile.Name())
+	if err != nil {
+		t.Fatal("Error while reading file:", err)
+	}
+
+	junk := []byte("RIFF\x00\x00garbage")
+	if err = os.WriteFile(tmpFile.Name(), append(junk, original...), 0o600); err != nil {
+		t.Fatal("Error while writing file:", err)
+	}
+
+	// Without scanning, the tag isn't found.
+	tag, err := Open(tmpFile.Name(), parseOpts)
+	if err != nil {
+		t.Fatal("Error while opening mp3 file:", err)
+	}
+
+	if tag.HasFrames() {
+		t.Error("Expected no frames without scanning")
+	}
+
+	tag.Close()
+
+	tag, err = Open(tmpFile.Name(), Options{Parse: true, ScanLimit: 1024})
+	if err != nil {
+		t.Fatal("Error while opening mp3 file:", err)
+	}
+	defer tag.Close()
+
+	if tag.Offset() != int64(len(junk)) {
+		t.Errorf("Expected offset %v, got %v", len(junk), tag.Offset())
+	}
+
+	if tag.Count() != countOfFrames {
+		t.Errorf("Expected frames %v, got %v", countOfFrames, tag.Count())
+	}
+
+	// Saving drops the junk bytes.
+	if err = tag.Save(); err != nil {
+		t.Fatal("Error while saving tag:", err)
+	}
+
+	repaired, err := os.ReadFile(tmpFile.Name())
+	if err != nil {
+		t.Fatal("Error while reading file:", err)
+	}
+
+	if !isID3Tag(repaired[:3]) {
+		t.Errorf("Expected file to start with ID3 identifier, got %q", repaired[:3])
+	}
+
+	if int64(len(repaired)) != int64(tag.Size())+musicSize {
+		t.Errorf("Expected file size %v, got %v", int64(tag.Size())+musicSize, len(repaired))
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+	t.Parallel()
+
+	frame := func(id string, body []byte) []byte {
+		header := []byte(id)
+		header = append(header, 0, 0, 0, byte(len(body)), 0, 0)
+
+		return append(header, body...)
+	}
+
+	frames := concat(
+		frame("TIT2", []byte("\x03Title")),
+		frame("CHAP", []byte("c\x00\x00\x00\x00\x00\x00\x00")),
+		frame("ab!d", []byte("xx")),
+		frame("TPE1", []byte("\x03Artist")),
+	)
+	data := concat([]byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, byte(len(frames))}, frames)
+
+	if _, err := ParseBytes(data, Options{Parse: true}); err == nil {
+		t.Error("Expected error in strict mode, got nil")
+	}
+
+	tag, err := ParseBytes(data, Options{Parse: true, Lenient: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tag.Title() != "Title" || tag.Artist() != "Artist" {
+		t.Errorf("Expected %v and %v, got %v and %v", "Title", "Artist", tag.Title(), tag.Artist())
+	}
+
+	warnings := tag.ParseWarnings()
+	if len(warnings) != 2 {
+		t.Fatalf("Expected %v warnings, got %v", 2, warnings)
+	}
+
+	if warnings[0].ID != "CHAP" || warnings[0].Offset != 26 {
+		t.Errorf("Expected CHAP at offset %v, got %v at %v", 26, warnings[0].ID, warnings[0].Offset)
+	}
+
+	if warnings[1].ID != "ab!d" || !errors.Is(warnings[1], ErrInvalidFrameID) {
+		t.Errorf("Expected %v for %v, got %v", ErrInvalidFrameID, "ab!d", warnings[1])
+	}
+}
+
+func TestParseLenientMalformedFrameIDs(t *testing.T) {
+	t.Parallel()
+
+	frame := func(id string, body []byte) []byte {
+		header := []byte(id)
+		header = append(header, 0, 0, 0, byte(len(body)), 0, 0)
+
+		return append(header, body...)
+	}
+
+	frames := concat(
+		frame("tit2", []byte("\x03Title")),
+		frame("TAL ", []byte("\x03Album")),
+		frame("TP1\x00", []byte("\x03Artist")),
+		frame("Comm", []byte("\x03eng\x00Comment")),
+		frame("PIC ", []byte("xx")),
+	)
+	data := concat([]byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, byte(len(frames))}, frames)
+
+	tag, err := ParseBytes(data, Options{Parse: true, Lenient: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tag.Title() != "Title" || tag.Album() != "Album" || tag.Artist() != "Artist" {
+		t.Errorf("Expected %v, %v and %v, got %v, %v and %v",
+			"Title", "Album", "Artist", tag.Title(), tag.Album(), tag.Artist())
+	}
+
+	comment, ok := tag.GetLastFrame("COMM").(CommentFrame)
+	if !ok || comment.Text != "Comment" {
+		t.Errorf("Expected comment %q, got %v", "Comment", tag.GetLastFrame("COMM"))
+	}
+
+	warnings := tag.ParseWarnings()
+	if len(warnings) != 1 || warnings[0].ID != "PIC " || !errors.Is(warnings[0], ErrInvalidFrameID) {
+		t.Errorf("Expected %v for %q, got %v", ErrInvalidFrameID, "PIC ", warnings)
+	}
+
+	// Strict parsing keeps the malformed IDs as they are.
+	tag, err = ParseBytes(data, Options{Parse: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tag.Title() != "" || tag.GetLastFrame("Comm") == nil {
+		t.Errorf("Expected malformed IDs to be kept, got %v", tag.AllFrames())
+	}
+}
+
+func TestParseLogger(t *testing.T) {
+	t.Parallel()
+
+	frame := func(id string, body []byte) []byte {
+		header := []byte(id)
+		header = append(header, 0, 0, 0, byte(len(body)), 0, 0)
+
+		return append(header, body...)
+	}
+
+	frames := concat(
+		frame("TIT2", []byte("\x09Title")),
+		frame("XYZW", []byte("data")),
+		frame("ab!d", []byte("xx")),
+	)
+	data := concat([]byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, byte(len(frames))}, frames)
+
+	logs := new(bytes.Buffer)
+	logger := slog.New(slog.NewTextHandler(logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	if _, err := ParseBytes(data, Options{Parse: true, Lenient: true, Logger: logger}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		`msg="invalid text encoding, falling back to UTF-8" id=TIT2`,
+		`msg="kept frame with unknown ID as raw data" id=XYZW`,
+		`msg="skipped malformed frame" id=ab!d`,
+	} {
+		if !strings.Contains(logs.String(), expected) {
+			t.Errorf("Expected logs to contain %q, got %q", expected, logs.String())
+		}
+	}
+}
+
+// TestParsePartialTag checks that the frames parsed before a malformed frame are kept with ErrIncompleteTag.
+func TestParsePartialTag(t *testing.T) {
+	t.Parallel()
+
+	frame := func(id string, body []byte) []byte {
+		header := []byte(id)
+		header = append(header, 0, 0, 0, byte(len(body)), 0, 0)
+
+		return append(header, body...)
+	}
+
+	frames := concat(
+		frame("TIT2", []byte("\x03Title")),
+		frame("CHAP", []byte("c\x00\x00\x00\x00\x00\x00\x00")),
+		frame("TPE1", []byte("\x03Artist")),
+	)
+	data := concat([]byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, byte(len(frames))}, frames)
+
+	tag, err := ParseBytes(data, Options{Parse: true})
+	if !errors.Is(err, ErrIncompleteTag) {
+		t.Fatalf("Expected %v, got %v", ErrIncompleteTag, err)
+	}
+
+	if tag.Title() != "Title" {
+		t.Errorf("Expected the frames before the failure to be kept, got title %q", tag.Title())
+	}
+}
+
+func TestParseFramesFunc(t *testing.T) {
+	t.Parallel()
+
+	source := NewEmptyTag()
+	source.SetTitle("Title")
+	source.SetArtist("Artist")
+	source.SetAlbum("Album")
+
+	data, err := source.Bytes()
+	if err != nil {
+		t.Fatalf("Error serializing tag: %v", err)
+	}
+
+	var ids []string
+
+	err = ParseFramesFunc(bytes.NewReader(data), Options{}, func(id string, frame Framer) error {
+		if _, ok := frame.(TextFrame); !ok {
+			t.Errorf("Expected a text frame, got %T", frame)
+		}
+
+		ids = append(ids, id)
+
+		return nil
+	})
+	if err != nil || len(ids) != 3 {
+		t.Errorf("Expected 3 frames, got %v (error: %v)", ids, err)
+	}
+
+	var title string
+
+	err = ParseFramesFunc(bytes.NewReader(data), Options{}, func(id string, frame Framer) error {
+		if id != FrameTIT2 {
+			return nil
+		}
+
+		title = frame.(TextFrame).Text
+
+		return ErrStopParsing
+	})
+	if err != nil || title != "Title" {
+		t.Errorf("Expected title %q, got %q (error: %v)", "Title", title, err)
+	}
+
+	errCallback := errors.New("callback failed")
+
+	err = ParseFramesFunc(bytes.NewReader(data), Options{}, func(string, Framer) error {
+		return errCallback
+	})
+	if !errors.Is(err, errCallback) || errors.Is(err, ErrIncompleteTag) {
+		t.Errorf("Expected %v, got %v", errCallback, err)
+	}
+}
+
+func TestParseTolerateWrongTagSize(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetTitle("Title")
+	tag.SetArtist("Artist")
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatalf("Error while writing tag: %v", err)
+	}
+
+	audio := []byte{0xFF, 0xFB, 0x90, 0x64, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	// Frames are written ordered by ID, so the title comes first.
+	firstFrameSize := frameHeaderSize + tag.GetLastFrame("TIT2").Size()
+
+	testCases := []struct {
+		name         string
+		declaredSize int
+		padding      int
+	}{
+		{"declared size too small", firstFrameSize, 0},
+		{"declared size too large", len(data) - tagHeaderSize + 100, 20},
+		{"declared size too small with padding", firstFrameSize, 20},
+	}
+
+	for _, tc := range testCases {
+		file := slices.Clone(data)
+		file[6], file[7], file[8], file[9] = 0, 0, byte(tc.declaredSize>>7)&0x7F, byte(tc.declaredSize)&0x7F
+		file = append(file, make([]byte, tc.padding)...)
+		file = append(file, audio...)
+
+		parsed, parseErr := ParseBytes(file, Options{Parse: true, TolerateWrongTagSize: true})
+		if parseErr != nil {
+			t.Fatalf("%s: error while parsing tag: %v", tc.name, parseErr)
+		}
+
+		if parsed.Title() != "Title" || parsed.Artist() != "Artist" {
+			t.Errorf("%s: expected both frames, got title %q and artist %q", tc.name, parsed.Title(), parsed.Artist())
+		}
+
+		if expected := int64(len(data) + tc.padding); parsed.originalSize != expected {
+			t.Errorf("%s: expected corrected tag size %d, got %d", tc.name, expected, parsed.originalSize)
+		}
+	}
+}
+
+func TestParseNonSynchsafeSizesHeuristic(t *testing.T) {
+	t.Parallel()
+
+	// A 256-byte text frame written with a plain integer size in an ID3v2.4 tag.
+	// Its size bytes are valid as a synchsafe integer too, but read that way the frame is 128 bytes long.
+	title := bytes.Repeat([]byte{'a'}, 255)
+	frames := concat(
+		[]byte{'T', 'I', 'T', '2', 0, 0, 1, 0, 0, 0, 3}, title,
+		[]byte{'T', 'P', 'E', '1', 0, 0, 0, 7, 0, 0, 3}, []byte("Artist"),
+		make([]byte, 16),
+	)
+	data := concat([]byte{'I', 'D', '3', 4, 0, 0, 0, 0, byte(len(frames) >> 7), byte(len(frames) & 0x7F)}, frames)
+
+	// The frame ends are read by seeking if possible, streams are buffered.
+	readers := map[string]io.Reader{
+		"seeker": bytes.NewReader(data),
+		"stream": struct{ io.Reader }{bytes.NewReader(data)},
+	}
+
+	for name, rd := range readers {
+		tag, err := ParseReader(rd, parseOpts)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		if tag.Title() != string(title) {
+			t.Errorf("%s: expected title of %v bytes, got %v", name, len(title), len(tag.Title()))
+		}
+
+		if tag.Artist() != "Artist" {
+			t.Errorf("%s: expected artist %q, got %q", name, "Artist", tag.Artist())
+		}
+
+		if !tag.nonSynchsafeSizes {
+			t.Errorf("%s: expected non-synchsafe sizes to be detected", name)
+		}
+	}
+}
+
+func TestParseLenientResynchronization(t *testing.T) {
+	t.Parallel()
+
+	frame := func(id string, body []byte) []byte {
+		header := []byte(id)
+		header = append(header, 0, 0, 0, byte(len(body)), 0, 0)
+
+		return append(header, body...)
+	}
+
+	testCases := []struct {
+		name    string
+		corrupt []byte
+		err     error
+	}{
+		{"invalid size", []byte{'C', 'O', 'M', 'M', 0x80, 0x80, 0x80, 0x80, 0, 0, 'j', 'u', 'n', 'k'}, ErrInvalidSizeFormat},
+		{"size exceeding tag", []byte{'C', 'O', 'M', 'M', 0, 0, 0x7F, 0x7F, 0, 0, 'j', 'u', 'n', 'k'}, ErrBodyOverflow},
+	}
+
+	for _, tc := range testCases {
+		frames := concat(frame("TIT2", []byte("\x03Title")), tc.corrupt, frame("TPE1", []byte("\x03Artist")))
+		data := concat([]byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, byte(len(frames))}, frames)
+
+		tag, err := ParseBytes(data, Options{Parse: true, Lenient: true})
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+
+		if tag.Title() != "Title" || tag.Artist() != "Artist" {
+			t.Errorf("%s: expected %v and %v, got %v and %v", tc.name, "Title", "Artist", tag.Title(), tag.Artist())
+		}
+
+		warnings := tag.ParseWarnings()
+		if len(warnings) != 1 {
+			t.Fatalf("%s: expected 1 warning, got %v", tc.name, warnings)
+		}
+
+		expectedOffset := int64(tagHeaderSize + frameHeaderSize + len("\x03Title"))
+		if warnings[0].Offset != expectedOffset || warnings[0].Skipped != int64(len(tc.corrupt)) ||
+			!errors.Is(warnings[0], tc.err) {
+			t.Errorf("%s: expected %v skipping %d bytes at offset %d, got %+v",
+				tc.name, tc.err, len(tc.corrupt), expectedOffset, warnings[0])
+		}
+	}
+}
+
+func TestParseTruncatedTag(t *testing.T) {
+	t.Parallel()
+
+	frame := func(id string, body []byte) []byte {
+		header := []byte(id)
+		header = append(header, 0, 0, 0, byte(len(body)), 0, 0)
+
+		return append(header, body...)
+	}
+
+	frames := concat(
+		frame("TIT2", []byte("\x03Title")),
+		frame("TPE1", []byte("\x03Artist")),
+		frame("TALB", []byte("\x03Album")),
+	)
+	data := concat([]byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, byte(len(frames) + 64)}, frames)
+
+	// Cut the file inside the last frame body, inside its header and right after it.
+	for _, cut := range []int{len(data) - 2, len(data) - len("\x03Album") - 4, len(data)} {
+		tag, err := ParseBytes(data[:cut], Options{Parse: true})
+		if !errors.Is(err, ErrTruncatedTag) || !errors.Is(err, ErrIncompleteTag) {
+			t.Fatalf("Expected %v, got %v", ErrTruncatedTag, err)
+		}
+
+		if tag.Title() != "Title" || tag.Artist() != "Artist" {
+			t.Errorf("Expected the complete frames to be kept, got title %q and artist %q", tag.Title(), tag.Artist())
+		}
+
+		if expected := cut == len(data); (tag.Album() == "Album") != expected {
+			t.Errorf("Cut at %d: expected album to be kept: %v, got %q", cut, expected, tag.Album())
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package id3v2
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// PatchOp is the kind of a patch operation.
+type PatchOp string
+
+// Supported patch operations.
+const (
+	// PatchOpSet replaces the frames of the key with the values.
+	PatchOpSet PatchOp = "set"
+	// PatchOpDelete deletes the frames of the key.
+	PatchOpDelete PatchOp = "delete"
+)
+
+// ErrInvalidPatchOperation is returned when a patch contains an unknown operation or an operation without a key.
+var ErrInvalidPatchOperation = errors.New("invalid patch operation")
+
+// PatchOperation is a single change of a tag.
+// Keys are the same as used by ToMap and ApplyMap (e.g., "Artist", "TXXX:<description>", "COMM:<description>").
+type PatchOperation struct {
+	Op     PatchOp  `json:"op"`
+	Key    string   `json:"key"`
+	Values []string `json:"values,omitempty"`
+}
+
+// Patch is an ordered list of changes of a tag.
+// It can be serialized to JSON, reviewed and applied later with tag.ApplyPatch,
+// which makes it suitable for review-then-apply workflows and undo logs.
+type Patch []PatchOperation
+
+// DiffToPatch returns the patch that turns the textual content of tag a into the content of tag b.
+// Only the content exported by ToMap is compared. Operations are sorted by key.
+func DiffToPatch(a, b *Tag) Patch {
+	from := a.ToMap()
+	to := b.ToMap()
+
+	var patch Patch
+
+	for _, key := range slices.Sorted(maps.Keys(to)) {
+		if values, ok := from[key]; ok && slices.Equal(values, to[key]) {
+			continue
+		}
+
+		patch = append(patch, PatchOperation{Op: PatchOpSet, Key: key, Values: slices.Clone(to[key])})
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(from)) {
+		if _, ok := to[key]; !ok {
+			patch = append(patch, PatchOperation{Op: PatchOpDelete, Key: key})
+		}
+	}
+
+	return patch
+}
+
+// ApplyPatch applies the operations of the patch to the tag in order.
+// The patch is validated before any change is made,
+// so the tag is left untouched if ErrInvalidPatchOperation is returned.
+// It returns ErrReadOnlyTag if the tag is read-only.
+func (tag *Tag) ApplyPatch(p Patch) error {
+	for i, op := range p {
+		if op.Key == "" {
+			return fmt.Errorf("%w: operation %d has no key", ErrInvalidPatchOperation, i)
+		}
+
+		if op.Op != PatchOpSet && op.Op != PatchOpDelete {
+			return fmt.Errorf("%w: operation %d has unknown type %q", ErrInvalidPatchOperation, i, op.Op)
+		}
+	}
+
+	if tag.rejectChange() {
+		return ErrReadOnlyTag
+	}
+
+	for _, op := range p {
+		var values []string
+		if op.Op == PatchOpSet {
+			values = op.Values
+		}
+
+		tag.ApplyMap(map[string][]string{op.Key: values})
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package id3v2
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestDiffToPatch(t *testing.T) {
+	t.Parallel()
+
+	a := NewEmptyTag()
+	a.SetArtist("Artist")
+	a.SetTitle("Title")
+	a.AddUserDefinedTextFrame(UserDefinedTextFrame{Encoding: EncodingUTF8, Description: "Mood", Value: "Calm"})
+
+	b := NewEmptyTag()
+	b.SetArtist("Artist")
+	b.SetTitle("New title")
+	b.SetAlbum("Album")
+
+	patch := DiffToPatch(a, b)
+
+	data, err := json.Marshal(patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded Patch
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.ApplyPatch(decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Title() != "New title" {
+		t.Errorf("Expected %v, got %v", "New title", a.Title())
+	}
+
+	if a.Album() != "Album" {
+		t.Errorf("Expected %v, got %v", "Album", a.Album())
+	}
+
+	if len(a.GetFrames(a.CommonID("User defined text information frame"))) != 0 {
+		t.Error("Expected user-defined text frame to be deleted")
+	}
+
+	if remaining := DiffToPatch(a, b); len(remaining) != 0 {
+		t.Errorf("Expected empty patch, got %v", remaining)
+	}
+}
+
+func TestApplyPatchInvalidOperation(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetArtist("Artist")
+
+	err := tag.ApplyPatch(Patch{
+		{Op: PatchOpDelete, Key: "Artist"},
+		{Op: "rename", Key: "Title"},
+	})
+	if !errors.Is(err, ErrInvalidPatchOperation) {
+		t.Errorf("Expected %v, got %v", ErrInvalidPatchOperation, err)
+	}
+
+	if tag.Artist() != "Artist" {
+		t.Errorf("Expected tag to be untouched, got artist %q", tag.Artist())
+	}
+}
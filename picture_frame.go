@@ -1,6 +1,8 @@
 package id3v2
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"io"
 )
@@ -85,7 +87,7 @@ func parsePictureFrame(br *bufferedReader, _ byte) (Framer, error) {
 	pictureType := br.ReadByte()
 
 	// Read the description using the specified encoding.
-	description := br.ReadText(encoding)
+	description := br.ReadTextBeforeData(encoding)
 
 	// Read the remaining bytes as the image data.
 	picture := br.ReadAll()
@@ -106,3 +108,40 @@ func parsePictureFrame(br *bufferedReader, _ byte) (Framer, error) {
 
 	return pf, nil
 }
+
+// ErrLinkedPicture is returned when the image data of a picture referenced by URL is requested.
+var ErrLinkedPicture = errors.New("picture is a link, not image data")
+
+// pictureExtensions maps MIME types of pictures to file extensions.
+var pictureExtensions = map[string]string{
+	MimeTypeGIF:  ".gif",
+	MimeTypeJPEG: ".jpg",
+	MimeTypePNG:  ".png",
+	MimeTypeWebP: ".webp",
+	"image/bmp":  ".bmp",
+	"image/tiff": ".tiff",
+}
+
+// defaultPictureExtension is the file extension used for pictures of unknown type.
+const defaultPictureExtension = ".bin"
+
+// WriteImageTo streams the raw image data to the provided writer.
+// It returns ErrLinkedPicture for pictures referenced by URL ("-->" MIME type).
+func (pf PictureFrame) WriteImageTo(w io.Writer) (int64, error) {
+	if pf.MimeType == linkedPictureMimeType {
+		return 0, ErrLinkedPicture
+	}
+
+	return io.Copy(w, bytes.NewReader(pf.Picture))
+}
+
+// Extension returns the file extension matching the picture, e.g. ".jpg".
+// The extension is inferred from the MIME type, normalized like in tag.FixPictureMimeTypes,
+// so ID3v2.2-style types and misdeclared data are handled. Unknown types get ".bin".
+func (pf PictureFrame) Extension() string {
+	if ext, ok := pictureExtensions[fixPictureMimeType(pf.MimeType, pf.Picture)]; ok {
+		return ext
+	}
+
+	return defaultPictureExtension
+}
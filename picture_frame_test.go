@@ -0,0 +1,120 @@
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPictureFrameWriteImageTo(t *testing.T) {
+	t.Parallel()
+
+	pf := PictureFrame{MimeType: MimeTypePNG, Picture: []byte("\x89PNG\r\n\x1a\nimage")}
+	buf := new(bytes.Buffer)
+
+	n, err := pf.WriteImageTo(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n != int64(len(pf.Picture)) || !bytes.Equal(buf.Bytes(), pf.Picture) {
+		t.Errorf("Expected %v bytes of image data, got %v", len(pf.Picture), n)
+	}
+
+	linked := PictureFrame{MimeType: "-->", Picture: []byte("https://example.com/cover.jpg")}
+	if _, err = linked.WriteImageTo(buf); !errors.Is(err, ErrLinkedPicture) {
+		t.Errorf("Expected %v, got %v", ErrLinkedPicture, err)
+	}
+}
+
+func TestPictureFrameExtension(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pf       PictureFrame
+		expected string
+	}{
+		{PictureFrame{MimeType: MimeTypeJPEG}, ".jpg"},
+		{PictureFrame{MimeType: "PNG"}, ".png"},
+		{PictureFrame{MimeType: MimeTypeJPEG, Picture: []byte("GIF89a")}, ".gif"},
+		{PictureFrame{MimeType: "application/octet-stream"}, ".bin"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.pf.Extension(); got != tt.expected {
+			t.Errorf("Expected %v for %q, got %v", tt.expected, tt.pf.MimeType, got)
+		}
+	}
+}
+
+func TestExtractFrontCover(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+
+	if _, err := tag.ExtractFrontCover(filepath.Join(t.TempDir(), "cover")); !errors.Is(err, ErrNoPicture) {
+		t.Errorf("Expected %v, got %v", ErrNoPicture, err)
+	}
+
+	back := []byte("GIF89a back")
+	front := []byte{0xFF, 0xD8, 0xFF, 0xE0, 'f', 'r', 'o', 'n', 't'}
+
+	tag.AddAttachedPicture(PictureFrame{MimeType: MimeTypeGIF, PictureType: PTBackCover, Picture: back})
+	tag.AddAttachedPicture(PictureFrame{MimeType: MimeTypeJPEG, PictureType: PTFrontCover, Picture: front})
+
+	path, err := tag.ExtractFrontCover(filepath.Join(t.TempDir(), "cover"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if filepath.Ext(path) != ".jpg" {
+		t.Errorf("Expected %v extension, got %v", ".jpg", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(data, front) {
+		t.Errorf("Expected %v, got %v", front, data)
+	}
+}
+
+func TestAddAttachedPictureSinglePictureTypes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		enabled  bool
+		expected int
+	}{
+		{"lenient", false, 3},
+		{"single", true, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tag := NewEmptyTag()
+			tag.SetSinglePictureTypes(tt.enabled)
+
+			tag.AddAttachedPicture(PictureFrame{PictureType: PTFrontCover, Description: "old", Picture: []byte{1}})
+			tag.AddAttachedPicture(PictureFrame{PictureType: PTArtistPerformer, Description: "a", Picture: []byte{2}})
+			tag.AddAttachedPicture(PictureFrame{PictureType: PTFrontCover, Description: "new", Picture: []byte{3}})
+
+			pictures := tag.GetFrames(tag.CommonID("Attached picture"))
+			if len(pictures) != tt.expected {
+				t.Fatalf("Expected %v pictures, got %v", tt.expected, len(pictures))
+			}
+
+			last, _ := pictures[len(pictures)-1].(PictureFrame)
+			if last.Description != "new" {
+				t.Errorf("Expected %q, got %q", "new", last.Description)
+			}
+		})
+	}
+}
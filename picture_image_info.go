@@ -0,0 +1,83 @@
+package id3v2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	_ "image/gif"  // Register the GIF format for image.DecodeConfig.
+	_ "image/jpeg" // Register the JPEG format for image.DecodeConfig.
+	_ "image/png"  // Register the PNG format for image.DecodeConfig.
+)
+
+// webPFormat is the format name reported by ImageInfo for WebP images.
+const webPFormat = "webp"
+
+// ImageInfo holds the dimensions and the format of a picture.
+type ImageInfo struct {
+	Width  int    // The width of the image in pixels.
+	Height int    // The height of the image in pixels.
+	Format string // The detected format: "jpeg", "png", "gif" or "webp".
+}
+
+// ImageInfo returns the dimensions and the format of the picture, reading only the image header.
+// The format is detected from the data, not from the declared MIME type.
+// It returns ErrLinkedPicture for pictures referenced by URL and image.ErrFormat for unsupported formats.
+// It's useful to choose the cover with the best resolution among several attached pictures.
+func (pf PictureFrame) ImageInfo() (ImageInfo, error) {
+	if pf.MimeType == linkedPictureMimeType {
+		return ImageInfo{}, ErrLinkedPicture
+	}
+
+	// The standard library has no WebP decoder.
+	if SniffPictureMimeType(pf.Picture) == MimeTypeWebP {
+		return webPImageInfo(pf.Picture)
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(pf.Picture))
+	if err != nil {
+		return ImageInfo{}, err
+	}
+
+	return ImageInfo{Width: config.Width, Height: config.Height, Format: format}, nil
+}
+
+// webPImageInfo reads the dimensions of a WebP image from its first chunk.
+// See https://developers.google.com/speed/webp/docs/riff_container.
+func webPImageInfo(data []byte) (ImageInfo, error) {
+	const chunkDataOffset = 20 // RIFF header (12 bytes) and chunk header (8 bytes).
+
+	if len(data) < chunkDataOffset+10 {
+		return ImageInfo{}, image.ErrFormat
+	}
+
+	chunk := data[chunkDataOffset:]
+	info := ImageInfo{Format: webPFormat}
+
+	switch string(data[12:16]) {
+	case "VP8 ":
+		// Lossy: 3 bytes of frame tag, start code 9D 01 2A, then 14-bit width and height.
+		if !bytes.Equal(chunk[3:6], []byte{0x9D, 0x01, 0x2A}) {
+			return ImageInfo{}, image.ErrFormat
+		}
+
+		info.Width = int(binary.LittleEndian.Uint16(chunk[6:8]) & 0x3FFF)
+		info.Height = int(binary.LittleEndian.Uint16(chunk[8:10]) & 0x3FFF)
+	case "VP8L":
+		// Lossless: signature 0x2F, then 14 bits of width - 1 and 14 bits of height - 1.
+		if chunk[0] != 0x2F {
+			return ImageInfo{}, image.ErrFormat
+		}
+
+		bits := binary.LittleEndian.Uint32(chunk[1:5])
+		info.Width = int(bits&0x3FFF) + 1
+		info.Height = int((bits>>14)&0x3FFF) + 1
+	case "VP8X":
+		// Extended: 4 bytes of flags, then 24 bits of canvas width - 1 and 24 bits of canvas height - 1.
+		info.Width = int(uint32(chunk[4])|uint32(chunk[5])<<8|uint32(chunk[6])<<16) + 1
+		info.Height = int(uint32(chunk[7])|uint32(chunk[8])<<8|uint32(chunk[9])<<16) + 1
+	default:
+		return ImageInfo{}, image.ErrFormat
+	}
+
+	return info, nil
+}
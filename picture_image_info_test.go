@@ -0,0 +1,63 @@
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func TestPictureFrameImageInfo(t *testing.T) {
+	t.Parallel()
+
+	img := image.NewRGBA(image.Rect(0, 0, 300, 200))
+
+	encode := func(encodeFunc func(*bytes.Buffer) error) []byte {
+		buf := new(bytes.Buffer)
+		if err := encodeFunc(buf); err != nil {
+			t.Fatal(err)
+		}
+
+		return buf.Bytes()
+	}
+
+	pngData := encode(func(buf *bytes.Buffer) error { return png.Encode(buf, img) })
+	jpegData := encode(func(buf *bytes.Buffer) error { return jpeg.Encode(buf, img, nil) })
+	gifData := encode(func(buf *bytes.Buffer) error { return gif.Encode(buf, img, nil) })
+
+	// Extended WebP with a 640x480 canvas.
+	webpData := concat([]byte("RIFF\x00\x00\x00\x00WEBPVP8X\x0a\x00\x00\x00"), []byte{0, 0, 0, 0, 0x7F, 0x02, 0, 0xDF, 0x01, 0})
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected ImageInfo
+	}{
+		{"png", pngData, ImageInfo{300, 200, "png"}},
+		{"jpeg", jpegData, ImageInfo{300, 200, "jpeg"}},
+		{"gif", gifData, ImageInfo{300, 200, "gif"}},
+		{"webp", webpData, ImageInfo{640, 480, "webp"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			info, err := PictureFrame{MimeType: "image/", Picture: tt.data}.ImageInfo()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if info != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, info)
+			}
+		})
+	}
+
+	if _, err := (PictureFrame{Picture: []byte("garbage")}).ImageInfo(); !errors.Is(err, image.ErrFormat) {
+		t.Errorf("Expected %v, got %v", image.ErrFormat, err)
+	}
+}
@@ -0,0 +1,101 @@
+package id3v2
+
+import (
+	"bytes"
+	"strings"
+)
+
+// MIME types of pictures recognized by SniffPictureMimeType.
+const (
+	MimeTypeJPEG = "image/jpeg"
+	MimeTypePNG  = "image/png"
+	MimeTypeGIF  = "image/gif"
+	MimeTypeWebP = "image/webp"
+)
+
+// pictureMimeTypeAliases maps ID3v2.2 image formats and common misspellings to MIME types.
+var pictureMimeTypeAliases = map[string]string{
+	"gif":       MimeTypeGIF,
+	"image/jpg": MimeTypeJPEG,
+	"jpeg":      MimeTypeJPEG,
+	"jpg":       MimeTypeJPEG,
+	"png":       MimeTypePNG,
+	"webp":      MimeTypeWebP,
+}
+
+// SniffPictureMimeType detects the MIME type of the image by its magic bytes.
+// JPEG, PNG, GIF and WebP are recognized. It returns an empty string for other data.
+func SniffPictureMimeType(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8, 0xFF}):
+		return MimeTypeJPEG
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return MimeTypePNG
+	case bytes.HasPrefix(data, []byte("GIF87a")), bytes.HasPrefix(data, []byte("GIF89a")):
+		return MimeTypeGIF
+	case len(data) >= 12 && bytes.Equal(data[0:4], []byte("RIFF")) && bytes.Equal(data[8:12], []byte("WEBP")):
+		return MimeTypeWebP
+	default:
+		return ""
+	}
+}
+
+// FixPictureMimeTypes verifies that the data of every attached picture (APIC frame)
+// matches its declared MIME type and fixes the mismatches.
+// ID3v2.2-style formats ("JPG", "PNG"), the "image/" shorthand and misspellings like "image/jpg"
+// are replaced with proper MIME types. Pictures referenced by URL ("-->") are left untouched.
+// The MIME type of data that can't be sniffed is only normalized.
+// It returns the number of fixed pictures.
+func (tag *Tag) FixPictureMimeTypes() int {
+	if tag.rejectChange() {
+		return 0
+	}
+
+	id := tag.CommonID("Attached picture")
+
+	s, ok := tag.sequences[id]
+	if !ok {
+		return 0
+	}
+
+	var fixed int
+
+	for i, frame := range s.frames {
+		pf, ok := frame.(PictureFrame)
+		if !ok {
+			continue
+		}
+
+		mimeType := fixPictureMimeType(pf.MimeType, pf.Picture)
+		if mimeType != pf.MimeType {
+			pf.MimeType = mimeType
+			s.frames[i] = tag.track(id, frame, pf)
+			fixed++
+		}
+	}
+
+	return fixed
+}
+
+// fixPictureMimeType returns the MIME type matching the picture data.
+func fixPictureMimeType(mimeType string, picture []byte) string {
+	if mimeType == linkedPictureMimeType {
+		return mimeType
+	}
+
+	if sniffed := SniffPictureMimeType(picture); sniffed != "" {
+		return sniffed
+	}
+
+	normalized := strings.ToLower(strings.TrimSpace(mimeType))
+	if alias, ok := pictureMimeTypeAliases[normalized]; ok {
+		return alias
+	}
+
+	if !strings.Contains(normalized, "/") && normalized != "" {
+		// Other ID3v2.2 image formats, e.g. "BMP".
+		return "image/" + normalized
+	}
+
+	return mimeType
+}
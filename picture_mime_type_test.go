@@ -0,0 +1,74 @@
+package id3v2
+
+import (
+	"testing"
+)
+
+func TestSniffPictureMimeType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected string
+	}{
+		{"jpeg", []byte{0xFF, 0xD8, 0xFF, 0xE0}, MimeTypeJPEG},
+		{"png", []byte("\x89PNG\r\n\x1a\n\x00"), MimeTypePNG},
+		{"gif", []byte("GIF89a..."), MimeTypeGIF},
+		{"webp", []byte("RIFF\x00\x00\x00\x00WEBPVP8 "), MimeTypeWebP},
+		{"unknown", []byte("BM...."), ""},
+		{"empty", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := SniffPictureMimeType(tt.data); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestFixPictureMimeTypes(t *testing.T) {
+	t.Parallel()
+
+	png := []byte("\x89PNG\r\n\x1a\n\x00")
+
+	tag := NewEmptyTag()
+	tag.AddAttachedPicture(PictureFrame{MimeType: MimeTypeJPEG, PictureType: PTFrontCover, Picture: png})
+	tag.AddAttachedPicture(PictureFrame{MimeType: "JPG", PictureType: PTBackCover, Picture: []byte("data")})
+	tag.AddAttachedPicture(PictureFrame{MimeType: "BMP", PictureType: PTMedia, Picture: []byte("data")})
+	tag.AddAttachedPicture(PictureFrame{MimeType: "-->", PictureType: PTArtistPerformer, Picture: []byte("https://x")})
+
+	tag.SetWriteOptions(WriteOptions{FixPictureMimeTypes: true})
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := ParseBytes(data, parseOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[byte]string{
+		PTFrontCover:      MimeTypePNG,
+		PTBackCover:       MimeTypeJPEG,
+		PTMedia:           "image/bmp",
+		PTArtistPerformer: "-->",
+	}
+
+	for _, f := range parsed.GetFrames(parsed.CommonID("Attached picture")) {
+		pf, _ := f.(PictureFrame)
+		if pf.MimeType != expected[pf.PictureType] {
+			t.Errorf("Expected %q for picture type %v, got %q", expected[pf.PictureType], pf.PictureType, pf.MimeType)
+		}
+	}
+
+	if fixed := parsed.FixPictureMimeTypes(); fixed != 0 {
+		t.Errorf("Expected %v fixed pictures, got %v", 0, fixed)
+	}
+}
@@ -0,0 +1,176 @@
+package id3v2
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const (
+	// podcastChaptersVersion is the version of the Podcasting 2.0 chapters format written by this library.
+	podcastChaptersVersion = "1.2.0"
+
+	// linkedPictureMimeType is the MIME type of picture frames that hold a URL instead of image data.
+	linkedPictureMimeType = "-->"
+)
+
+// PodcastChapters represents a Podcasting 2.0 chapters JSON document.
+// The format is described here:
+// https://github.com/Podcastindex-org/podcast-namespace/blob/main/chapters/jsonChapters.md.
+type PodcastChapters struct {
+	Version  string           `json:"version"`  // The version of the format.
+	Chapters []PodcastChapter `json:"chapters"` // The chapters ordered by their start time.
+}
+
+// PodcastChapter represents a single chapter of a Podcasting 2.0 chapters JSON document.
+type PodcastChapter struct {
+	StartTime float64 `json:"startTime"`         // The start time in seconds.
+	EndTime   float64 `json:"endTime,omitempty"` // The end time in seconds (optional).
+	Title     string  `json:"title,omitempty"`   // The title of the chapter (optional).
+	Img       string  `json:"img,omitempty"`     // The URL of the chapter image (optional).
+	URL       string  `json:"url,omitempty"`     // The URL of a web page related to the chapter (optional).
+	TOC       *bool   `json:"toc,omitempty"`     // Whether the chapter is listed in the table of contents.
+}
+
+// PodcastChapters converts the chapter frames of the tag to a Podcasting 2.0 chapters document.
+// The chapter title, link and linked artwork (a picture frame with the "-->" MIME type) are converted
+// to title, url and img. Chapters that aren't listed in the top-level table of contents
+// (if the tag has one) are marked with "toc": false.
+func (tag *Tag) PodcastChapters() PodcastChapters {
+	listed := tag.topLevelChapterIDs()
+	pc := PodcastChapters{Version: podcastChaptersVersion, Chapters: []PodcastChapter{}}
+
+	for _, cf := range tag.Chapters() {
+		chapter := PodcastChapter{
+			StartTime: cf.StartTime.Seconds(),
+			EndTime:   cf.EndTime.Seconds(),
+		}
+
+		if cf.Title != nil {
+			chapter.Title = cf.Title.Text
+		}
+
+		if cf.Link != nil {
+			chapter.URL = cf.Link.URL
+		}
+
+		if cf.Artwork != nil && cf.Artwork.MimeType == linkedPictureMimeType {
+			chapter.Img = string(cf.Artwork.Picture)
+		}
+
+		if listed != nil && !listed[cf.ElementID] {
+			hidden := false
+			chapter.TOC = &hidden
+		}
+
+		pc.Chapters = append(pc.Chapters, chapter)
+	}
+
+	return pc
+}
+
+// WritePodcastChapters writes the chapter frames of the tag as a Podcasting 2.0 chapters JSON document to w.
+func (tag *Tag) WritePodcastChapters(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(tag.PodcastChapters())
+}
+
+// ParsePodcastChapters reads and parses a Podcasting 2.0 chapters JSON document from the provided io.Reader.
+// Use AddToTag to convert the chapters to chapter frames.
+func ParsePodcastChapters(inputReader io.Reader) (PodcastChapters, error) {
+	var pc PodcastChapters
+
+	if err := json.NewDecoder(inputReader).Decode(&pc); err != nil {
+		return PodcastChapters{}, err
+	}
+
+	return pc, nil
+}
+
+// AddToTag adds the chapters to the tag as chapter frames with a top-level table of contents.
+// Chapters without an end time end where the next chapter starts.
+// The url becomes the chapter link and the img becomes a linked artwork picture frame.
+// Chapters marked with "toc": false aren't listed in the table of contents.
+func (pc PodcastChapters) AddToTag(tag *Tag) {
+	chapters := slices.Clone(pc.Chapters)
+	slices.SortStableFunc(chapters, func(a, b PodcastChapter) int {
+		return cmp.Compare(a.StartTime, b.StartTime)
+	})
+
+	tocf := TableOfContentsFrame{
+		ElementID: defaultTableOfContentsElementID,
+		TopLevel:  true,
+		Ordered:   true,
+	}
+
+	for i, chapter := range chapters {
+		endTime := chapter.EndTime
+		if endTime == 0 && i+1 < len(chapters) {
+			endTime = chapters[i+1].StartTime
+		}
+
+		cf := ChapterFrame{
+			ElementID:   defaultChapterElementIDPrefix + strconv.Itoa(i+1),
+			StartTime:   secondsToDuration(chapter.StartTime),
+			EndTime:     secondsToDuration(max(endTime, chapter.StartTime)),
+			StartOffset: IgnoredOffset,
+			EndOffset:   IgnoredOffset,
+		}
+
+		if chapter.Title != "" {
+			cf.Title = &TextFrame{Encoding: tag.DefaultEncoding(), Text: chapter.Title}
+		}
+
+		if chapter.URL != "" {
+			cf.Link = &LinkFrame{Encoding: tag.DefaultEncoding(), URL: chapter.URL}
+		}
+
+		if chapter.Img != "" {
+			cf.Artwork = &PictureFrame{
+				Encoding:    tag.DefaultEncoding(),
+				MimeType:    linkedPictureMimeType,
+				PictureType: PTOther,
+				Picture:     []byte(chapter.Img),
+			}
+		}
+
+		tag.AddChapterFrame(cf)
+
+		if chapter.TOC == nil || *chapter.TOC {
+			tocf.ChildElementIDs = append(tocf.ChildElementIDs, cf.ElementID)
+		}
+	}
+
+	tag.AddTableOfContentsFrame(tocf)
+}
+
+// topLevelChapterIDs returns the set of element IDs listed in the top-level table of contents.
+// It returns nil if the tag has no top-level table of contents.
+func (tag *Tag) topLevelChapterIDs() map[string]bool {
+	for _, f := range tag.GetFrames(tag.CommonID("Table of contents")) {
+		tocf, ok := f.(TableOfContentsFrame)
+		if !ok || !tocf.TopLevel {
+			continue
+		}
+
+		ids := make(map[string]bool, len(tocf.ChildElementIDs))
+		for _, id := range tocf.ChildElementIDs {
+			ids[id] = true
+		}
+
+		return ids
+	}
+
+	return nil
+}
+
+// secondsToDuration converts fractional seconds to a duration rounded to milliseconds,
+// the precision of chapter frames.
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds*1000+0.5) * time.Millisecond
+}
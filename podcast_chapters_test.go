@@ -0,0 +1,108 @@
+package id3v2
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPodcastChaptersRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	chaptersJSON := `{
+  "version": "1.2.0",
+  "chapters": [
+    {"startTime": 65.5, "title": "Second", "url": "https://example.com", "img": "https://example.com/2.jpg"},
+    {"startTime": 0, "endTime": 65.5, "title": "Intro"},
+    {"startTime": 120, "endTime": 180, "title": "Ad", "toc": false}
+  ]
+}`
+
+	pc, err := ParsePodcastChapters(strings.NewReader(chaptersJSON))
+	if err != nil {
+		t.Fatalf("Error parsing chapters: %v", err)
+	}
+
+	tag := NewEmptyTag()
+	pc.AddToTag(tag)
+
+	// Serialize and parse the tag to make sure links and artworks survive.
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatalf("Error serializing tag: %v", err)
+	}
+
+	parsed, err := ParseBytes(data, parseOpts)
+	if err != nil {
+		t.Fatalf("Error parsing tag: %v", err)
+	}
+
+	hidden := false
+	expected := PodcastChapters{
+		Version: "1.2.0",
+		Chapters: []PodcastChapter{
+			{StartTime: 0, EndTime: 65.5, Title: "Intro"},
+			{
+				StartTime: 65.5,
+				EndTime:   120,
+				Title:     "Second",
+				Img:       "https://example.com/2.jpg",
+				URL:       "https://example.com",
+			},
+			{StartTime: 120, EndTime: 180, Title: "Ad", TOC: &hidden},
+		},
+	}
+
+	if got := parsed.PodcastChapters(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+
+	buf := new(bytes.Buffer)
+	if err = parsed.WritePodcastChapters(buf); err != nil {
+		t.Fatalf("Error writing chapters: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), `"img": "https://example.com/2.jpg"`) {
+		t.Errorf("Expected img in JSON, got %v", buf.String())
+	}
+}
+
+func TestChapterFrameLinkAndArtwork(t *testing.T) {
+	t.Parallel()
+
+	cf := ChapterFrame{
+		ElementID: "chp1",
+		Link:      &LinkFrame{Encoding: EncodingUTF8, Description: "Site", URL: "https://example.com"},
+		Artwork:   &PictureFrame{Encoding: EncodingUTF8, MimeType: "image/jpeg", Picture: []byte{1, 2, 3}},
+	}
+
+	buf := new(bytes.Buffer)
+
+	n, err := cf.WriteTo(buf)
+	if err != nil {
+		t.Fatalf("Error writing chapter frame: %v", err)
+	}
+
+	if int(n) != cf.Size() {
+		t.Errorf("Expected size %v, got %v", cf.Size(), n)
+	}
+
+	frame, err := parseChapterFrame(newBufferedReader(buf), 4)
+	if err != nil {
+		t.Fatalf("Error parsing chapter frame: %v", err)
+	}
+
+	parsed, _ := frame.(ChapterFrame)
+	if parsed.Title != nil || parsed.Description != nil {
+		t.Errorf("Expected absent subframes to be nil, got %v and %v", parsed.Title, parsed.Description)
+	}
+
+	if !reflect.DeepEqual(parsed.Link, cf.Link) {
+		t.Errorf("Expected link %+v, got %+v", cf.Link, parsed.Link)
+	}
+
+	if !reflect.DeepEqual(parsed.Artwork, cf.Artwork) {
+		t.Errorf("Expected artwork %+v, got %+v", cf.Artwork, parsed.Artwork)
+	}
+}
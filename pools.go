@@ -89,6 +89,7 @@ func getBufReader(rd io.Reader) *bufferedReader {
 
 // putBufReader returns a buffered reader to the pool for reuse.
 func putBufReader(rd *bufferedReader) {
+	rd.legacyUTF16 = false
 	rdPool.Put(rd) // Add the reader back to the pool.
 }
 
@@ -104,3 +104,61 @@ func parsePopularimeterFrame(br *bufferedReader, _ byte) (Framer, error) {
 
 	return pf, nil
 }
+
+// SetDefaultPopularimeterEmail sets the identity (usually an email address) whose popularimeter frame
+// is read and updated by Rating, SetRating, PlayCount and IncrementPlayCount.
+// By default, the identity is empty.
+func (tag *Tag) SetDefaultPopularimeterEmail(email string) {
+	tag.popularimeterEmail = email
+}
+
+// GetPopularimeter returns the popularimeter frame (POPM) of the identity.
+// The second value reports whether the frame was found.
+func (tag *Tag) GetPopularimeter(email string) (PopularimeterFrame, bool) {
+	for _, f := range tag.GetFrames(tag.CommonID("Popularimeter")) {
+		if pf, ok := f.(PopularimeterFrame); ok && pf.Email == email {
+			return pf, true
+		}
+	}
+
+	return PopularimeterFrame{}, false
+}
+
+// Rating returns the rating of the default identity, or 0 if it's unknown.
+func (tag *Tag) Rating() uint8 {
+	pf, _ := tag.GetPopularimeter(tag.popularimeterEmail)
+
+	return pf.Rating
+}
+
+// SetRating sets the rating of the default identity, keeping its play count.
+func (tag *Tag) SetRating(rating uint8) {
+	pf := tag.defaultPopularimeter()
+	pf.Rating = rating
+
+	tag.AddFrame(tag.CommonID("Popularimeter"), pf)
+}
+
+// PlayCount returns the play count of the default identity.
+func (tag *Tag) PlayCount() *big.Int {
+	return tag.defaultPopularimeter().Counter
+}
+
+// IncrementPlayCount increments the play count of the default identity, keeping its rating.
+func (tag *Tag) IncrementPlayCount() {
+	pf := tag.defaultPopularimeter()
+	pf.Counter = new(big.Int).Add(pf.Counter, big.NewInt(1))
+
+	tag.AddFrame(tag.CommonID("Popularimeter"), pf)
+}
+
+// defaultPopularimeter returns the popularimeter frame of the default identity,
+// or a new frame with a zero counter if there is none.
+func (tag *Tag) defaultPopularimeter() PopularimeterFrame {
+	pf, ok := tag.GetPopularimeter(tag.popularimeterEmail)
+	if !ok || pf.Counter == nil {
+		pf = PopularimeterFrame{Email: tag.popularimeterEmail, Rating: pf.Rating, Counter: new(big.Int)}
+	}
+
+	return pf
+}
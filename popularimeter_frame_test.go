@@ -34,3 +34,49 @@ func TestPopularimeterFrameSmallCounter(t *testing.T) {
 		t.Fatalf("Expected popularimeter counter: %v, got: %v", expectedCounter, gotCounter)
 	}
 }
+
+func TestPopularimeterDefaultIdentity(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.AddFrame(tag.CommonID("Popularimeter"), PopularimeterFrame{
+		Email:   "alice@example.com",
+		Rating:  128,
+		Counter: big.NewInt(10),
+	})
+	tag.AddFrame(tag.CommonID("Popularimeter"), PopularimeterFrame{
+		Email:   "bob@example.com",
+		Rating:  255,
+		Counter: big.NewInt(3),
+	})
+
+	tag.SetDefaultPopularimeterEmail("alice@example.com")
+
+	if rating := tag.Rating(); rating != 128 {
+		t.Errorf("Expected rating %d, got %d", 128, rating)
+	}
+
+	tag.SetRating(200)
+	tag.IncrementPlayCount()
+
+	alice, ok := tag.GetPopularimeter("alice@example.com")
+	if !ok || alice.Rating != 200 || alice.Counter.Int64() != 11 {
+		t.Errorf("Expected rating 200 and play count 11, got %d and %v (found: %v)", alice.Rating, alice.Counter, ok)
+	}
+
+	bob, _ := tag.GetPopularimeter("bob@example.com")
+	if bob.Rating != 255 || bob.Counter.Int64() != 3 {
+		t.Errorf("Expected rating 255 and play count 3, got %d and %v", bob.Rating, bob.Counter)
+	}
+
+	tag.SetDefaultPopularimeterEmail("carol@example.com")
+	tag.IncrementPlayCount()
+
+	if count := tag.PlayCount(); count.Int64() != 1 {
+		t.Errorf("Expected play count 1, got %v", count)
+	}
+
+	if rating := tag.Rating(); rating != 0 {
+		t.Errorf("Expected rating 0, got %d", rating)
+	}
+}
@@ -0,0 +1,131 @@
+package id3v2
+
+// Profile is a set of writer settings matching what a player ecosystem actually reads.
+// Apply it with tag.ApplyProfile instead of configuring the version, encodings and padding one by one.
+type Profile struct {
+	Name               string       // The name of the profile, e.g. "iTunes".
+	Version            byte         // The ID3v2 version of the tag.
+	Encoding           Encoding     // The encoding of all text in the tag.
+	WriteOptions       WriteOptions // The settings used when the tag is serialized.
+	SinglePictureTypes bool         // Whether only one picture of restricted types is kept, see tag.SetSinglePictureTypes.
+}
+
+var (
+	// ProfileITunes targets iTunes and Apple Music. They read ID3v2.4, but write ID3v2.3 with UTF-16 text,
+	// and older versions and iPods ignore UTF-8 text. Picture MIME types are fixed,
+	// as covers with wrong MIME types aren't shown, and adding a front cover replaces the existing one,
+	// as only the first one is used.
+	ProfileITunes = Profile{
+		Name:               "iTunes",
+		Version:            3,
+		Encoding:           EncodingUTF16,
+		WriteOptions:       WriteOptions{Padding: 2048, FixPictureMimeTypes: true},
+		SinglePictureTypes: true,
+	}
+
+	// ProfileWindows targets Windows Explorer and Windows Media Player.
+	// Windows before version 10 doesn't read ID3v2.4 tags at all and no version reads UTF-8 text,
+	// so ID3v2.3 with UTF-16 text is used.
+	ProfileWindows = Profile{
+		Name:         "Windows",
+		Version:      3,
+		Encoding:     EncodingUTF16,
+		WriteOptions: WriteOptions{Padding: 1024, FixPictureMimeTypes: true},
+	}
+
+	// ProfileStrict24 produces tags that follow the ID3v2.4 standard to the letter,
+	// for modern players and music servers: ID3v2.4 with UTF-8 text.
+	ProfileStrict24 = Profile{
+		Name:         "Strict ID3v2.4",
+		Version:      4,
+		Encoding:     EncodingUTF8,
+		WriteOptions: WriteOptions{Padding: 1024, FixPictureMimeTypes: true},
+	}
+)
+
+// ApplyProfile configures the tag for the ecosystem described by the profile in one call:
+// it sets the version, the default encoding, the write options and the picture settings,
+// re-encodes the text of all frames and converts the dates to the frames of the version
+// (TYER, TDAT and TIME in ID3v2.3, TDRC in ID3v2.4, see tag.SetReleaseDate,
+// and TORY or TDOR, see tag.SetOriginalReleaseDate). Malformed dates are left as they are.
+func (tag *Tag) ApplyProfile(p Profile) {
+	tag.convertDates(p.Version)
+
+	tag.SetVersion(p.Version)
+	tag.SetDefaultEncoding(p.Encoding)
+	tag.SetWriteOptions(p.WriteOptions)
+	tag.SetSinglePictureTypes(p.SinglePictureTypes)
+
+	for id, frame := range tag.frames {
+		tag.frames[id] = tag.track(id, frame, withEncoding(frame, p.Encoding))
+	}
+
+	for id, s := range tag.sequences {
+		for i, frame := range s.frames {
+			s.frames[i] = tag.track(id, frame, withEncoding(frame, p.Encoding))
+		}
+	}
+}
+
+// convertDates moves the release dates to the frames used by the version and switches the tag to it.
+func (tag *Tag) convertDates(version byte) {
+	if version == tag.Version() || version < 3 || version > 4 || tag.rejectChange() {
+		return
+	}
+
+	release, releaseErr := tag.ReleaseDate()
+	original, originalErr := tag.OriginalReleaseDate()
+
+	tag.version = version
+
+	if releaseErr == nil && !release.IsZero() {
+		for _, id := range []string{"TDRC", "TYER", "TDAT", "TIME"} {
+			tag.DeleteFrames(id)
+		}
+
+		tag.SetReleaseDate(release)
+	}
+
+	if originalErr == nil && !original.IsZero() {
+		tag.DeleteFrames("TDOR")
+		tag.DeleteFrames("TORY")
+		tag.SetOriginalReleaseDate(original)
+	}
+}
+
+// withEncoding returns a copy of the frame with the text encoding replaced.
+// Frames without text encoding are returned as is.
+func withEncoding(frame Framer, encoding Encoding) Framer {
+	switch f := frame.(type) {
+	case TextFrame:
+		f.Encoding = encoding
+
+		return f
+	case UserDefinedTextFrame:
+		f.Encoding = encoding
+
+		return f
+	case CommentFrame:
+		f.Encoding = encoding
+
+		return f
+	case PictureFrame:
+		f.Encoding = encoding
+
+		return f
+	case LinkFrame:
+		f.Encoding = encoding
+
+		return f
+	case UnsynchronisedLyricsFrame:
+		f.Encoding = encoding
+
+		return f
+	case SynchronisedLyricsFrame:
+		f.Encoding = encoding
+
+		return f
+	default:
+		return frame
+	}
+}
@@ -0,0 +1,55 @@
+package id3v2
+
+import (
+	"testing"
+	"time"
+)
+
+func TestApplyProfile(t *testing.T) {
+	t.Parallel()
+
+	date := Timestamp{Time: time.Date(2024, time.May, 17, 0, 0, 0, 0, time.UTC), Precision: PrecisionDay}
+
+	tag := NewEmptyTag()
+	tag.SetVersion(4)
+	tag.SetTitle("Title")
+	tag.SetReleaseDate(date)
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Text: "Comment"})
+
+	tag.ApplyProfile(ProfileITunes)
+
+	if tag.Version() != 3 || !tag.DefaultEncoding().Equals(EncodingUTF16) {
+		t.Errorf("Expected ID3v2.3 with %s, got ID3v2.%d with %s", EncodingUTF16, tag.Version(), tag.DefaultEncoding())
+	}
+
+	if tag.WriteOptions().Padding != ProfileITunes.WriteOptions.Padding {
+		t.Errorf("Expected padding %d, got %d", ProfileITunes.WriteOptions.Padding, tag.WriteOptions().Padding)
+	}
+
+	if tag.GetTextFrame("TDRC").Text != "" || tag.GetTextFrame("TYER").Text != "2024" ||
+		tag.GetTextFrame("TDAT").Text != "1705" {
+		t.Errorf("Expected the date to be moved to TYER and TDAT, got frames %v", tag.AllFrames())
+	}
+
+	for id, frames := range tag.AllFrames() {
+		for _, frame := range frames {
+			if encoding, ok := frameEncoding(frame); ok && !encoding.Equals(EncodingUTF16) {
+				t.Errorf("Expected frame %s encoded in %s, got %s", id, EncodingUTF16, encoding)
+			}
+		}
+	}
+
+	tag.ApplyProfile(ProfileStrict24)
+
+	if ts, err := tag.ReleaseDate(); err != nil || ts.String() != "2024-05-17" {
+		t.Errorf("Expected release date %q, got %q (error: %v)", "2024-05-17", ts, err)
+	}
+
+	if tag.GetTextFrame("TYER").Text != "" || tag.GetTextFrame("TDAT").Text != "" {
+		t.Errorf("Expected ID3v2.3 date frames to be removed, got frames %v", tag.AllFrames())
+	}
+
+	if issues := tag.Validate(); len(issues) != 0 {
+		t.Errorf("Expected a valid tag, got %v", issues)
+	}
+}
@@ -0,0 +1,58 @@
+package id3v2
+
+import (
+	"cmp"
+	"slices"
+)
+
+// PrunePolicy defines the size budgets applied by tag.Prune.
+type PrunePolicy struct {
+	// MaxSizes maps frame IDs or descriptions (e.g., "APIC" or "Attached picture")
+	// to the maximum sizes of the frame bodies in bytes. Non-positive sizes are ignored.
+	MaxSizes map[string]int
+
+	// DryRun determines whether the oversized frames are only reported and kept in the tag.
+	DryRun bool
+}
+
+// PrunedFrame describes a frame exceeding its size budget, see tag.Prune.
+type PrunedFrame struct {
+	FrameID string // The ID of the frame.
+	Frame   Framer // The frame itself.
+	Size    int    // The size of the frame body in bytes.
+}
+
+// Prune removes the frames whose bodies exceed the sizes given by policy,
+// e.g. pictures larger than 1 MB before uploading to services with tag size limits.
+// It returns the oversized frames ordered by frame ID. With policy.DryRun set
+// or if the tag is read-only, the frames are reported but not removed, see tag.Err.
+func (tag *Tag) Prune(policy PrunePolicy) []PrunedFrame {
+	var pruned []PrunedFrame
+
+	for key, maxSize := range policy.MaxSizes {
+		if maxSize <= 0 {
+			continue
+		}
+
+		id := tag.CommonID(key)
+		oversized := func(f Framer) bool {
+			return f.Size() > maxSize
+		}
+
+		for _, f := range tag.GetFrames(id) {
+			if oversized(f) {
+				pruned = append(pruned, PrunedFrame{FrameID: id, Frame: f, Size: f.Size()})
+			}
+		}
+
+		if !policy.DryRun {
+			tag.deleteFramesFunc(id, oversized)
+		}
+	}
+
+	slices.SortStableFunc(pruned, func(a, b PrunedFrame) int {
+		return cmp.Compare(a.FrameID, b.FrameID)
+	})
+
+	return pruned
+}
@@ -0,0 +1,61 @@
+package id3v2
+
+import "testing"
+
+func TestPrune(t *testing.T) {
+	t.Parallel()
+
+	newTag := func() *Tag {
+		tag := NewEmptyTag()
+		tag.SetTitle("Title")
+
+		for i, size := range []int{100, 5000} {
+			tag.AddAttachedPicture(PictureFrame{
+				Encoding:    EncodingUTF8,
+				MimeType:    MimeTypeJPEG,
+				PictureType: byte(i),
+				Picture:     make([]byte, size),
+			})
+		}
+
+		return tag
+	}
+
+	policy := PrunePolicy{MaxSizes: map[string]int{"Attached picture": 1000, "TIT2": 100, "TPE1": 0}}
+
+	tag := newTag()
+	pruned := tag.Prune(PrunePolicy{MaxSizes: policy.MaxSizes, DryRun: true})
+
+	if len(pruned) != 1 || pruned[0].FrameID != "APIC" || pruned[0].Size != pruned[0].Frame.Size() {
+		t.Fatalf("Unexpected pruned frames: %+v", pruned)
+	}
+
+	if count := len(tag.GetFrames("APIC")); count != 2 {
+		t.Errorf("Expected 2 pictures after a dry run, got %d", count)
+	}
+
+	pruned = tag.Prune(policy)
+	if len(pruned) != 1 {
+		t.Fatalf("Expected 1 pruned frame, got %d", len(pruned))
+	}
+
+	pictures := tag.GetFrames("APIC")
+	if len(pictures) != 1 || len(pictures[0].(PictureFrame).Picture) != 100 {
+		t.Errorf("Expected only the small picture to remain, got %v", pictures)
+	}
+
+	if tag.Title() != "Title" {
+		t.Errorf("Expected the title to remain, got %q", tag.Title())
+	}
+
+	readOnly := newTag()
+	readOnly.SetReadOnly(true)
+
+	if pruned = readOnly.Prune(policy); len(pruned) != 1 {
+		t.Errorf("Expected 1 reported frame, got %d", len(pruned))
+	}
+
+	if count := len(readOnly.GetFrames("APIC")); count != 2 {
+		t.Errorf("Expected 2 pictures in a read-only tag, got %d", count)
+	}
+}
@@ -0,0 +1,32 @@
+package id3v2
+
+// SetReadOnly sets whether the tag is read-only, e.g. to release a tag parsed with Options.ReadOnly
+// after it was verified. Changes of the frames and the version of a read-only tag are rejected:
+// setters like SetTitle leave the tag unchanged and record ErrReadOnlyTag, reported by Err,
+// and Save, DeleteTag and Reset return ErrReadOnlyTag. WriteTo still serializes the tag.
+// SetReadOnly clears the error reported by Err.
+func (tag *Tag) SetReadOnly(readOnly bool) {
+	tag.readOnly = readOnly
+	tag.err = nil
+}
+
+// ReadOnly reports whether the tag is read-only, see SetReadOnly.
+func (tag *Tag) ReadOnly() bool {
+	return tag.readOnly
+}
+
+// Err returns ErrReadOnlyTag if a change of the read-only tag was rejected, and nil otherwise.
+// Setters don't return errors, so a batch of changes can be checked with a single call afterwards.
+func (tag *Tag) Err() error {
+	return tag.err
+}
+
+// rejectChange reports whether a change must be rejected because the tag is read-only,
+// recording ErrReadOnlyTag for Err if so.
+func (tag *Tag) rejectChange() bool {
+	if tag.readOnly {
+		tag.err = ErrReadOnlyTag
+	}
+
+	return tag.readOnly
+}
@@ -0,0 +1,195 @@
+package id3v2
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestReadOnlyTag(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "test.mp3")
+	copyTestFile(t, path)
+
+	tag, err := Open(path, Options{Parse: true, ReadOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tag.Close()
+
+	title := tag.Title()
+
+	tag.SetTitle("Changed")
+	tag.DeleteFrames(tag.CommonID("Comments"))
+	tag.DeleteAllFrames()
+	tag.SetVersion(3)
+
+	if !tag.ReadOnly() || tag.Title() != title || tag.Version() != 4 || tag.Modified() {
+		t.Errorf("Expected read-only tag to stay unchanged, got title %q in version %d", tag.Title(), tag.Version())
+	}
+
+	if len(tag.GetFrames(tag.CommonID("Comments"))) == 0 {
+		t.Error("Expected comments to be kept")
+	}
+
+	if err = tag.Save(); !errors.Is(err, ErrReadOnlyTag) {
+		t.Errorf("Expected %v, got %v", ErrReadOnlyTag, err)
+	}
+
+	if err = tag.Err(); !errors.Is(err, ErrReadOnlyTag) {
+		t.Errorf("Expected %v from Err, got %v", ErrReadOnlyTag, err)
+	}
+
+	tag.SetReadOnly(false)
+
+	if err = tag.Err(); err != nil {
+		t.Errorf("Expected no error after releasing the tag, got %v", err)
+	}
+	tag.SetTitle("Changed")
+
+	if tag.Title() != "Changed" {
+		t.Errorf("Expected title %q after releasing the tag, got %q", "Changed", tag.Title())
+	}
+
+	if err = tag.Save(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReadOnlyTagSetters checks that every change of a read-only tag is rejected and reported by Err.
+func TestReadOnlyTagSetters(t *testing.T) {
+	t.Parallel()
+
+	source := NewEmptyTag()
+	source.SetTitle("Long title")
+	source.SetArtist("Artist")
+	source.AddTextFrame("TPE2", EncodingUTF8, "")
+	source.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Text: "Comment"})
+	source.AddAttachedPicture(PictureFrame{
+		Encoding: EncodingUTF8, MimeType: "image/jpg", PictureType: PTFrontCover, Picture: []byte{0xFF, 0xD8, 0xFF},
+	})
+	source.AddUserDefinedTextFrame(UserDefinedTextFrame{Encoding: EncodingUTF8, Description: "Key", Value: "1"})
+	source.AddUserDefinedTextFrame(UserDefinedTextFrame{Encoding: EncodingUTF8, Description: "key ", Value: "2"})
+	source.AddFrame("TIPL", TextFrame{Encoding: EncodingUTF8, Multi: []string{"producer", "Name"}})
+	source.AddFrame("IPLS", TextFrame{Encoding: EncodingUTF8, Multi: []string{"producer", "Name"}})
+	source.AddFrame(string(FramePRIV), UnknownFrame{Body: []byte("private")})
+	source.AddFrame(string(FramePRIV), UnknownFrame{Body: []byte("private")})
+	source.AddChapterFrame(ChapterFrame{ElementID: "ch1", StartTime: 0, EndTime: time.Second})
+
+	data, err := source.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	setters := map[string]func(tag *Tag) error{
+		"AddFrame":           func(tag *Tag) error { tag.AddFrame("TALB", TextFrame{Text: "Album"}); return nil },
+		"SetTitle":           func(tag *Tag) error { tag.SetTitle("Changed"); return nil },
+		"SetArtist":          func(tag *Tag) error { tag.SetArtist("Changed"); return nil },
+		"SetAlbum":           func(tag *Tag) error { tag.SetAlbum("Changed"); return nil },
+		"SetYear":            func(tag *Tag) error { tag.SetYear("2000"); return nil },
+		"SetGenre":           func(tag *Tag) error { tag.SetGenre("Rock"); return nil },
+		"SetUserDefinedText": func(tag *Tag) error { tag.SetUserDefinedText("Other", "value"); return nil },
+		"SetRating":          func(tag *Tag) error { tag.SetRating(5); return nil },
+		"IncrementPlayCount": func(tag *Tag) error { tag.IncrementPlayCount(); return nil },
+		"SetVersion":         func(tag *Tag) error { tag.SetVersion(3); return nil },
+		"DeleteFrames":       func(tag *Tag) error { tag.DeleteFrames("TIT2"); return nil },
+		"DeleteAllFrames":    func(tag *Tag) error { tag.DeleteAllFrames(); return nil },
+		"ApplyMap":           func(tag *Tag) error { tag.ApplyMap(map[string][]string{"TIT2": nil}); return nil },
+		"ApplyProfile":       func(tag *Tag) error { tag.ApplyProfile(ProfileITunes); return nil },
+		"Restore":            func(tag *Tag) error { tag.Restore(NewEmptyTag().Snapshot()); return nil },
+		"FixPictureMimeTypes": func(tag *Tag) error {
+			if fixed := tag.FixPictureMimeTypes(); fixed != 0 {
+				return fmt.Errorf("%d pictures reported as fixed", fixed)
+			}
+
+			return nil
+		},
+		"TruncateTexts": func(tag *Tag) error {
+			if truncations := tag.TruncateTexts(map[string]int{"Title": 4}); len(truncations) > 0 {
+				return fmt.Errorf("truncations reported: %v", truncations)
+			}
+
+			return nil
+		},
+		"Repair": func(tag *Tag) error {
+			if fixes := tag.Repair(); len(fixes) > 0 {
+				return fmt.Errorf("fixes reported: %v", fixes)
+			}
+
+			return nil
+		},
+		"Deduplicate": func(tag *Tag) error {
+			if removed := tag.Deduplicate(); removed != 0 {
+				return fmt.Errorf("%d frames reported as removed", removed)
+			}
+
+			return nil
+		},
+		"DeduplicateUserDefinedTextFrames": func(tag *Tag) error {
+			if removed := tag.DeduplicateUserDefinedTextFrames(); removed != 0 {
+				return fmt.Errorf("%d frames reported as removed", removed)
+			}
+
+			return nil
+		},
+		"RemoveCompatibilityFrames": func(tag *Tag) error {
+			if removed := tag.RemoveCompatibilityFrames(); removed != 0 {
+				return fmt.Errorf("%d frames reported as removed", removed)
+			}
+
+			return nil
+		},
+		"Prune": func(tag *Tag) error {
+			// The oversized frames of a read-only tag are reported like with DryRun.
+			tag.Prune(PrunePolicy{MaxSizes: map[string]int{"APIC": 1}})
+
+			return nil
+		},
+		"ConvertToV23": func(tag *Tag) error {
+			if changes := tag.ConvertToV23(); len(changes) > 0 {
+				return fmt.Errorf("changes reported: %v", changes)
+			}
+
+			return nil
+		},
+		"ApplyPatch": func(tag *Tag) error {
+			return tag.ApplyPatch(Patch{{Op: PatchOpSet, Key: "TIT2", Values: []string{"Changed"}}})
+		},
+		"ApplyLRCMetadata": func(tag *Tag) error {
+			return tag.ApplyLRCMetadata(ParseLRCFileParsingResult{Metadata: map[string]string{LRCTagAlbum: "Album"}})
+		},
+		"SetAudioChecksum": func(tag *Tag) error { return tag.SetAudioChecksum(ChecksumCRC32) },
+		"SetChapterByteOffsets": func(tag *Tag) error {
+			return tag.SetChapterByteOffsets(AudioInfo{Duration: time.Minute, Bitrate: 128})
+		},
+	}
+
+	for name, set := range setters {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tag, err := ParseBytes(data, Options{Parse: true, ReadOnly: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			frames := tag.AllFrames()
+
+			if err = set(tag); err != nil && !errors.Is(err, ErrReadOnlyTag) {
+				t.Errorf("Expected %v, got %v", ErrReadOnlyTag, err)
+			}
+
+			if err = tag.Err(); !errors.Is(err, ErrReadOnlyTag) {
+				t.Errorf("Expected %v from Err, got %v", ErrReadOnlyTag, err)
+			}
+
+			if !reflect.DeepEqual(tag.AllFrames(), frames) || tag.Version() != 4 || tag.Modified() {
+				t.Errorf("Expected read-only tag to stay unchanged, got %v in version %d", tag.AllFrames(), tag.Version())
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// ReadStats describes the reads made by ParseReaderAt.
+type ReadStats struct {
+	Requests int   // The number of ReadAt calls.
+	Bytes    int64 // The number of bytes read.
+}
+
+// countingReaderAt counts the reads made from the underlying reader.
+type countingReaderAt struct {
+	r     io.ReaderAt
+	stats ReadStats
+}
+
+// ReadAt reads from the underlying reader and counts the read.
+func (cr *countingReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	n, err := cr.r.ReadAt(p, off)
+	cr.stats.Requests++
+	cr.stats.Bytes += int64(n)
+
+	return n, err
+}
+
+// ParseReaderAt parses the tag at the beginning of the file of the size read from r,
+// reading as few bytes as possible, so metadata scans of files on HTTP or S3 backends
+// don't download whole files. It first reads the 10-byte tag header and then exactly the declared tag size.
+// If opts.ParseFrames is set, only the frame headers and the bodies of the listed frames are read,
+// one range per frame, and the offsets in parse warnings and raw frames refer to the listed frames only.
+// The returned statistics count the reads, also if parsing fails.
+// AudioInfo and AudioReader of the returned tag read from r. The tag can't be saved.
+func ParseReaderAt(r io.ReaderAt, size int64, opts Options) (*Tag, ReadStats, error) {
+	if r == nil {
+		return nil, ReadStats{}, errors.New("r is nil")
+	}
+
+	cr := &countingReaderAt{r: r}
+
+	tag, err := parseReaderAt(cr, size, opts)
+	if tag != nil {
+		tag.reader = io.NewSectionReader(r, 0, size)
+	}
+
+	return tag, cr.stats, err
+}
+
+// parseReaderAt parses the tag read from r, see ParseReaderAt.
+func parseReaderAt(r io.ReaderAt, size int64, opts Options) (*Tag, error) {
+	header, err := readAtMost(r, 0, min(size, tagHeaderSize))
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := Detect(bytes.NewReader(header))
+	if err != nil || !opts.Parse {
+		// Files without a tag and unparsed tags need no more bytes.
+		return ParseBytes(header, opts)
+	}
+
+	// Frame headers of unsynchronised ID3v2.3 tags can only be found after reversing the unsynchronisation.
+	if len(opts.ParseFrames) == 0 || (info.Unsynchronisation && info.Version == 3) {
+		var body []byte
+
+		if body, err = readAtMost(r, tagHeaderSize, min(size, info.TotalSize())-tagHeaderSize); err != nil {
+			return nil, err
+		}
+
+		return ParseBytes(append(header, body...), opts)
+	}
+
+	frames, err := readListedFrames(r, info, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// The listed frames are parsed as a tag of their own, which is then given the size of the real tag.
+	var buf bytes.Buffer
+
+	bw := getBufWriter(&buf)
+	defer putBufWriter(bw)
+
+	err = writeTagHeader(bw, uint(len(frames)), info.Version, info.Revision, info.Flags&^HeaderFlagFooter)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = bw.Write(frames); err != nil {
+		return nil, err
+	}
+
+	if err = bw.Flush(); err != nil {
+		return nil, err
+	}
+
+	tag, err := ParseBytes(buf.Bytes(), opts)
+	if tag != nil {
+		tag.header = info
+		tag.headerFlags.Footer = info.Footer
+		tag.originalSize = info.TotalSize()
+	}
+
+	return tag, err
+}
+
+// readListedFrames reads the frame headers of the tag described by info and returns the frames
+// listed in opts.ParseFrames, headers included. The bodies of other frames aren't read.
+func readListedFrames(r io.ReaderAt, info Info, opts Options) ([]byte, error) {
+	ids := (&Tag{version: info.Version}).makeIDsFromDescriptions(opts.ParseFrames)
+	synchSafe := info.Version == 4
+	end := tagHeaderSize + info.Size
+
+	var frames []byte
+
+	for offset := int64(tagHeaderSize); offset+frameHeaderSize <= end && len(ids) > 0; {
+		data, err := readAtMost(r, offset, frameHeaderSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data) < frameHeaderSize {
+			return nil, ErrTruncatedTag
+		}
+
+		header, err := parseFrameHeaderBytes(data, synchSafe)
+		if err != nil {
+			// Padding or a frame that can't be skipped ends the frames. Parsing reports the latter.
+			if !errors.Is(err, ErrBlankFrame) {
+				frames = append(frames, data...)
+			}
+
+			break
+		}
+
+		next := offset + frameHeaderSize + header.BodySize
+
+		if ids[header.ID] {
+			var body []byte
+
+			if body, err = readAtMost(r, offset+frameHeaderSize, min(next, end)-offset-frameHeaderSize); err != nil {
+				return nil, err
+			}
+
+			frames = append(frames, data...)
+			frames = append(frames, body...)
+
+			if opts.StopWhenParseFramesSeen || !mustFrameBeInSequence(header.ID) {
+				delete(ids, header.ID)
+			}
+		}
+
+		offset = next
+	}
+
+	return frames, nil
+}
+
+// readAtMost reads n bytes at the offset from r. Fewer bytes are returned if r ends before.
+func readAtMost(r io.ReaderAt, offset, n int64) ([]byte, error) {
+	data := make([]byte, max(n, 0))
+
+	read, err := r.ReadAt(data, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	return data[:read], nil
+}
@@ -0,0 +1,81 @@
+package id3v2
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParseReaderAt(t *testing.T) {
+	t.Parallel()
+
+	source := NewEmptyTag()
+	source.SetTitle("Title")
+	source.SetArtist("Artist")
+	source.AddAttachedPicture(PictureFrame{
+		Encoding:    EncodingUTF8,
+		MimeType:    MimeTypeJPEG,
+		PictureType: PTFrontCover,
+		Picture:     make([]byte, 100000),
+	})
+	source.SetWriteOptions(WriteOptions{Padding: 1000})
+
+	tagData, err := source.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := concat(tagData, makeMPEGFrames(10))
+	r := bytes.NewReader(data)
+
+	tag, stats, err := ParseReaderAt(r, r.Size(), parseOpts)
+	if err != nil {
+		t.Fatal("Error while parsing tag:", err)
+	}
+
+	if stats.Requests != 2 || stats.Bytes != int64(len(tagData)) {
+		t.Errorf("Expected 2 requests reading %d bytes, got %+v", len(tagData), stats)
+	}
+
+	if tag.Title() != "Title" || len(tag.GetFrames("APIC")) != 1 {
+		t.Errorf("Expected the title and the picture, got %v", tag.AllFrames())
+	}
+
+	// Only the frame headers and the listed frames are read.
+	tag, stats, err = ParseReaderAt(r, r.Size(), Options{Parse: true, ParseFrames: []string{"Title"}})
+	if err != nil {
+		t.Fatal("Error while parsing tag:", err)
+	}
+
+	if stats.Bytes >= 1000 {
+		t.Errorf("Expected less than 1000 bytes to be read, got %+v", stats)
+	}
+
+	if frames := tag.AllFrames(); len(frames) != 1 || tag.Title() != "Title" {
+		t.Errorf("Expected only the title, got %v", frames)
+	}
+
+	if offset := tag.AudioOffset(); offset != int64(len(tagData)) {
+		t.Errorf("Expected audio offset %d, got %d", len(tagData), offset)
+	}
+
+	info, err := tag.AudioInfo()
+	if err != nil {
+		t.Fatal("Error while reading audio info:", err)
+	}
+
+	if info.Frames != 10 {
+		t.Errorf("Expected 10 MPEG frames, got %d", info.Frames)
+	}
+
+	// Files without a tag need the first 10 bytes only.
+	r = bytes.NewReader(makeMPEGFrames(10))
+
+	tag, stats, err = ParseReaderAt(r, r.Size(), parseOpts)
+	if err != nil {
+		t.Fatal("Error while parsing file without tag:", err)
+	}
+
+	if tag.HasFrames() || stats.Requests != 1 || stats.Bytes != tagHeaderSize {
+		t.Errorf("Expected an empty tag after reading %d bytes, got %v and %+v", tagHeaderSize, tag.AllFrames(), stats)
+	}
+}
@@ -0,0 +1,215 @@
+package id3v2
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// RepairFix describes a single fix applied by tag.Repair.
+type RepairFix struct {
+	FrameID string // The ID of the fixed frame, empty for tag-level fixes.
+	Message string // A human-readable description of the fix.
+}
+
+// String returns the fix formatted as "frame ID: message".
+func (rf RepairFix) String() string {
+	if rf.FrameID == "" {
+		return rf.Message
+	}
+
+	return rf.FrameID + ": " + rf.Message
+}
+
+// Repair fixes common corruptions produced by buggy taggers and returns the list of applied fixes
+// ordered by frame ID. The fixes are made in memory, call Save to write them to the file.
+//
+// It fixes:
+//   - text with wrong termination bytes, i.e. trailing null characters and empty trailing values;
+//   - UTF-16 text written without a BOM in little-endian byte order, which is decoded as big-endian;
+//   - ID3v2.4 frame sizes written as plain integers instead of synchsafe ones
+//     (the tag is always written with synchsafe sizes);
+//   - text frames and user-defined text frames without any value, which are deleted;
+//   - invalid language codes, which are coerced with NormalizeLanguageCode or replaced with "und".
+func (tag *Tag) Repair() []RepairFix {
+	if tag.rejectChange() {
+		return nil
+	}
+
+	var fixes []RepairFix
+
+	if tag.nonSynchsafeSizes {
+		fixes = append(fixes, RepairFix{Message: "frame sizes will be written as synchsafe integers"})
+		tag.nonSynchsafeSizes = false
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(tag.AllFrames())) {
+		if frame, ok := tag.frames[id]; ok {
+			repaired, messages := repairFrame(frame)
+			for _, message := range messages {
+				fixes = append(fixes, RepairFix{FrameID: id, Message: message})
+			}
+
+			if repaired == nil {
+				tag.DeleteFrames(id)
+			} else {
+				tag.frames[id] = tag.track(id, frame, repaired)
+			}
+
+			continue
+		}
+
+		s := tag.sequences[id]
+		frames := s.frames[:0]
+
+		for _, frame := range s.frames {
+			repaired, messages := repairFrame(frame)
+			for _, message := range messages {
+				fixes = append(fixes, RepairFix{FrameID: id, Message: message})
+			}
+
+			if repaired = tag.track(id, frame, repaired); repaired != nil {
+				frames = append(frames, repaired)
+			}
+		}
+
+		s.frames = frames
+		if len(frames) == 0 {
+			tag.DeleteFrames(id)
+		}
+	}
+
+	return fixes
+}
+
+// repairFrame returns the repaired copy of the frame and descriptions of the applied fixes.
+// It returns a nil frame if the frame has no content and should be deleted.
+func repairFrame(frame Framer) (Framer, []string) {
+	var messages []string
+
+	fixText := func(s string) string {
+		fixed := repairText(s)
+		if fixed != s {
+			messages = append(messages, "fixed text "+strconv.Quote(s))
+		}
+
+		return fixed
+	}
+
+	fixLanguage := func(language string) string {
+		fixed := repairLanguageCode(language)
+		if fixed != language {
+			messages = append(messages, "replaced invalid language code "+strconv.Quote(language)+" with "+strconv.Quote(fixed))
+		}
+
+		return fixed
+	}
+
+	switch f := frame.(type) {
+	case TextFrame:
+		f.Text = fixText(f.Text)
+		f.Multi = repairValues(f.Multi, fixText)
+
+		if f.Text == "" && len(f.Multi) == 0 {
+			return nil, append(messages, "deleted empty text frame")
+		}
+
+		return f, messages
+	case UserDefinedTextFrame:
+		f.Description = fixText(f.Description)
+		f.Value = fixText(f.Value)
+		f.Multi = repairValues(f.Multi, fixText)
+
+		if f.Value == "" && len(f.Multi) == 0 {
+			return nil, append(messages, "deleted empty user-defined text frame "+strconv.Quote(f.Description))
+		}
+
+		return f, messages
+	case CommentFrame:
+		f.Language = fixLanguage(f.Language)
+		f.Description = fixText(f.Description)
+		f.Text = fixText(f.Text)
+
+		return f, messages
+	case UnsynchronisedLyricsFrame:
+		f.Language = fixLanguage(f.Language)
+		f.ContentDescriptor = fixText(f.ContentDescriptor)
+		f.Lyrics = fixText(f.Lyrics)
+
+		return f, messages
+	case SynchronisedLyricsFrame:
+		f.Language = fixLanguage(f.Language)
+		f.ContentDescriptor = fixText(f.ContentDescriptor)
+
+		return f, messages
+	default:
+		return frame, nil
+	}
+}
+
+// repairValues repairs every value and drops empty trailing values left by wrong termination bytes.
+func repairValues(values []string, fix func(string) string) []string {
+	if len(values) == 0 {
+		return values
+	}
+
+	repaired := make([]string, len(values))
+	for i, value := range values {
+		repaired[i] = fix(value)
+	}
+
+	for len(repaired) > 0 && repaired[len(repaired)-1] == "" {
+		repaired = repaired[:len(repaired)-1]
+	}
+
+	return repaired
+}
+
+// repairText removes trailing null characters and restores text
+// that was written as little-endian UTF-16 without a BOM and decoded as big-endian.
+func repairText(s string) string {
+	s = strings.TrimRight(s, "\x00")
+
+	if swapped, ok := swapUTF16ByteOrder(s); ok {
+		return strings.TrimRight(swapped, "\x00")
+	}
+
+	return s
+}
+
+// swapUTF16ByteOrder returns the text with the bytes of every character swapped
+// if every character has a zero low byte and a printable high byte,
+// which is how Latin-1 text looks when little-endian UTF-16 is decoded as big-endian.
+func swapUTF16ByteOrder(s string) (string, bool) {
+	if s == "" || !utf8.ValidString(s) {
+		return "", false
+	}
+
+	var sb strings.Builder
+
+	for _, r := range s {
+		if r > 0xFFFF || r&0xFF != 0 {
+			return "", false
+		}
+
+		swapped := r >> 8
+		if swapped < 0x20 || (swapped >= 0x7F && swapped < 0xA0) {
+			return "", false
+		}
+
+		sb.WriteRune(swapped)
+	}
+
+	return sb.String(), true
+}
+
+// repairLanguageCode returns a valid language code for the given one.
+func repairLanguageCode(language string) string {
+	if normalized, ok := NormalizeLanguageCode(language); ok {
+		return normalized
+	}
+
+	return UndeterminedISO6392Code
+}
@@ -0,0 +1,80 @@
+package id3v2
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRepair(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.AddTextFrame("TIT2", EncodingUTF8, "Title\x00\x00")
+	tag.AddTextFrame("TALB", EncodingUTF8, "")
+	tag.AddFrame("TPE1", TextFrame{Encoding: EncodingUTF16, Text: "䄀戀挀"})
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "ENG", Text: "Comment"})
+	tag.AddUnsynchronisedLyricsFrame(UnsynchronisedLyricsFrame{
+		Encoding: EncodingUTF8,
+		Language: "12",
+		Lyrics:   "Lyrics",
+	})
+
+	fixes := tag.Repair()
+
+	expected := []RepairFix{
+		{"COMM", `replaced invalid language code "ENG" with "eng"`},
+		{"TALB", "deleted empty text frame"},
+		{"TIT2", `fixed text "Title\x00\x00"`},
+		{"TPE1", `fixed text "䄀戀挀"`},
+		{"USLT", `replaced invalid language code "12" with "und"`},
+	}
+
+	if len(fixes) != len(expected) {
+		t.Fatalf("Expected %v fixes, got %v: %v", len(expected), len(fixes), fixes)
+	}
+
+	for i := range expected {
+		if fixes[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected[i], fixes[i])
+		}
+	}
+
+	if tag.Title() != "Title" {
+		t.Errorf("Expected %q, got %q", "Title", tag.Title())
+	}
+
+	if tag.Artist() != "Abc" {
+		t.Errorf("Expected %q, got %q", "Abc", tag.Artist())
+	}
+
+	if tag.GetLastFrame("TALB") != nil {
+		t.Error("Expected empty TALB frame to be deleted")
+	}
+
+	if fixes = tag.Repair(); len(fixes) != 0 {
+		t.Errorf("Expected no fixes on repaired tag, got %v", fixes)
+	}
+}
+
+func TestRepairNonSynchsafeSizes(t *testing.T) {
+	t.Parallel()
+
+	// A 200-byte text frame written with a plain integer size in an ID3v2.4 tag.
+	body := append([]byte{3}, bytes.Repeat([]byte{'a'}, 199)...)
+	frame := concat([]byte{'T', 'I', 'T', '2', 0, 0, 0, 200, 0, 0}, body)
+	data := concat([]byte{'I', 'D', '3', 4, 0, 0, 0, 0, 1, byte(len(frame) - 128)}, frame)
+
+	tag, err := ParseBytes(data, parseOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tag.Title()) != 199 {
+		t.Errorf("Expected title of %v bytes, got %v", 199, len(tag.Title()))
+	}
+
+	fixes := tag.Repair()
+	if len(fixes) != 1 || fixes[0].FrameID != "" {
+		t.Errorf("Expected one tag-level fix, got %v", fixes)
+	}
+}
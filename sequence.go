@@ -13,24 +13,38 @@ type sequence struct {
 
 // AddFrame adds a frame to the sequence. If a frame with the same unique identifier already exists,
 // it replaces the existing frame. Otherwise, it appends the new frame to the sequence.
-func (s *sequence) AddFrame(f Framer) {
-	i := indexOfFrame(f, s.frames) // Find the index of the frame with the same unique identifier.
+// It returns the replaced frame, or nil if the frame was appended.
+func (s *sequence) AddFrame(f Framer) Framer {
+	return s.addFrameWithKey(f, Framer.UniqueIdentifier)
+}
+
+// addFrameWithKey adds a frame to the sequence like AddFrame,
+// but frames with the same key replace each other instead of frames with the same unique identifier.
+func (s *sequence) addFrameWithKey(f Framer, key SequenceKeyFunc) Framer {
+	i := indexOfFrame(f, s.frames, key) // Find the index of the frame with the same key.
 
 	if i == -1 {
 		// If the frame doesn't exist in the sequence, append it.
 		s.frames = append(s.frames, f)
-	} else {
-		// If the frame already exists, replace it with the new one.
-		s.frames[i] = f
+
+		return nil
 	}
+
+	// If the frame already exists, replace it with the new one.
+	replaced := s.frames[i]
+	s.frames[i] = f
+
+	return replaced
 }
 
 // indexOfFrame searches for a frame in the given slice of frames and returns its index.
-// It uses the frame's unique identifier to determine if two frames are the same.
+// It uses the key to determine if two frames are the same.
 // If the frame is not found, it returns -1.
-func indexOfFrame(f Framer, fs []Framer) int {
+func indexOfFrame(f Framer, fs []Framer, key SequenceKeyFunc) int {
+	k := key(f)
+
 	for i, ff := range fs {
-		if f.UniqueIdentifier() == ff.UniqueIdentifier() {
+		if k == key(ff) {
 			return i // Return the index if the frame is found.
 		}
 	}
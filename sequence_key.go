@@ -0,0 +1,36 @@
+package id3v2
+
+// SequenceKeyFunc returns the key identifying a frame in a sequence of frames with the same ID,
+// see tag.SetSequenceKey. Frames with the same key replace each other.
+type SequenceKeyFunc func(f Framer) string
+
+// SetSequenceKey sets the function deciding which frames with the ID (or description, e.g. "Comments")
+// replace each other when added, as tools have different duplicate semantics.
+// For example, a key returning the picture type treats pictures with different descriptions as equal,
+// and a key returning the language keeps a single comment per language.
+// By default, frames with the same unique identifier (see Framer.UniqueIdentifier) replace each other.
+// A nil key restores the default. Frames that are already in the tag aren't affected.
+func (tag *Tag) SetSequenceKey(id string, key SequenceKeyFunc) {
+	id = tag.CommonID(id)
+
+	if key == nil {
+		delete(tag.sequenceKeys, id)
+
+		return
+	}
+
+	if tag.sequenceKeys == nil {
+		tag.sequenceKeys = make(map[string]SequenceKeyFunc)
+	}
+
+	tag.sequenceKeys[id] = key
+}
+
+// sequenceKey returns the key function of the sequence of frames with the ID.
+func (tag *Tag) sequenceKey(id string) SequenceKeyFunc {
+	if key, ok := tag.sequenceKeys[id]; ok {
+		return key
+	}
+
+	return Framer.UniqueIdentifier
+}
@@ -0,0 +1,48 @@
+package id3v2
+
+import "testing"
+
+func TestSetSequenceKey(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetSequenceKey("Comments", func(f Framer) string {
+		cf, _ := f.(CommentFrame)
+
+		return cf.Language
+	})
+
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: "A", Text: "1"})
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: "B", Text: "2"})
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "deu", Description: "A", Text: "3"})
+
+	comments := tag.GetFrames("COMM")
+	if len(comments) != 2 {
+		t.Fatalf("Expected 2 comments, got %d", len(comments))
+	}
+
+	if cf := comments[0].(CommentFrame); cf.Text != "2" {
+		t.Errorf("Expected the second English comment to replace the first one, got %q", cf.Text)
+	}
+
+	// The key is kept in the copy of the tag made for writing.
+	tag.SetTextNormalizers(TrimSpace)
+
+	out, err := tag.serialized()
+	if err != nil {
+		t.Fatalf("Error while serializing the tag: %v", err)
+	}
+
+	out.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "deu", Description: "B", Text: "4"})
+
+	if count := len(out.GetFrames("COMM")); count != 2 {
+		t.Errorf("Expected 2 comments in the copy, got %d", count)
+	}
+
+	tag.SetSequenceKey("COMM", nil)
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: "C", Text: "5"})
+
+	if count := len(tag.GetFrames("COMM")); count != 3 {
+		t.Errorf("Expected 3 comments with the default key, got %d", count)
+	}
+}
@@ -0,0 +1,75 @@
+package id3v2
+
+import (
+	"maps"
+	"slices"
+)
+
+// Snapshot is a saved state of the frames of a tag, see tag.Snapshot.
+type Snapshot struct {
+	frames          map[string]Framer
+	sequences       map[string][]Framer
+	version         byte
+	defaultEncoding Encoding
+	modified        bool
+	saves           int
+}
+
+// Snapshot saves the frames, the version and the default encoding of the tag,
+// so they can be brought back with Restore, e.g. to implement undo in an editor
+// or to revert a tag if a validation step fails before saving.
+// Frames are values and the library never modifies their slices in place, so a snapshot is cheap:
+// it shares the frame data with the tag. Frames must not be modified in place after a snapshot
+// either, e.g. by changing the bytes of a picture retrieved from the tag.
+func (tag *Tag) Snapshot() Snapshot {
+	snapshot := Snapshot{
+		frames:          maps.Clone(tag.frames),
+		sequences:       make(map[string][]Framer, len(tag.sequences)),
+		version:         tag.version,
+		defaultEncoding: tag.defaultEncoding,
+		modified:        tag.modified,
+		saves:           tag.saves,
+	}
+
+	for id, s := range tag.sequences {
+		snapshot.sequences[id] = slices.Clone(s.frames)
+	}
+
+	return snapshot
+}
+
+// Restore brings back the frames, the version and the default encoding saved with Snapshot.
+// Settings like the write options aren't affected. The tag counts as modified after a restore
+// if it was modified when the snapshot was taken or it was saved since then.
+func (tag *Tag) Restore(snapshot Snapshot) {
+	if tag.rejectChange() {
+		return
+	}
+
+	modified := snapshot.modified || snapshot.saves != tag.saves
+
+	tag.DeleteAllFrames()
+
+	for id, f := range snapshot.frames {
+		tag.frames[id] = f
+	}
+
+	for id, frames := range snapshot.sequences {
+		s := getSequence()
+		s.frames = slices.Clone(frames)
+		tag.sequences[id] = s
+	}
+
+	tag.version = snapshot.version
+	tag.defaultEncoding = snapshot.defaultEncoding
+	tag.modified = modified
+
+	if len(tag.frameAddedObservers) > 0 {
+		// The callback never fails.
+		_ = tag.iterateOverAllFramesInOrder(func(id string, f Framer) error {
+			tag.notifyFrameAdded(id, f)
+
+			return nil
+		})
+	}
+}
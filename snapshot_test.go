@@ -0,0 +1,36 @@
+package id3v2
+
+import "testing"
+
+func TestSnapshotRestore(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetTitle("Title")
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: "A", Text: "Text"})
+
+	snapshot := tag.Snapshot()
+
+	tag.SetTitle("Other")
+	tag.SetVersion(3)
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: "B", Text: "Text"})
+	tag.DeleteFrames("TIT2")
+
+	tag.Restore(snapshot)
+
+	if tag.Title() != "Title" || tag.Version() != 4 || !tag.DefaultEncoding().Equals(EncodingUTF8) {
+		t.Errorf("Expected title %q in version 4, got %q in version %d", "Title", tag.Title(), tag.Version())
+	}
+
+	if count := len(tag.GetFrames(tag.CommonID("Comments"))); count != 1 {
+		t.Errorf("Expected 1 comment, got %d", count)
+	}
+
+	// The snapshot isn't affected by changes after the restore.
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: "C", Text: "Text"})
+	tag.Restore(snapshot)
+
+	if count := len(tag.GetFrames(tag.CommonID("Comments"))); count != 1 {
+		t.Errorf("Expected 1 comment after restoring again, got %d", count)
+	}
+}
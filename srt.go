@@ -0,0 +1,144 @@
+package id3v2
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// defaultCueDuration is the duration in milliseconds of the last cue exported from a SYLT frame,
+// because SYLT entries have only a start time and end where the next entry starts.
+const defaultCueDuration = 3000
+
+// ErrUnsupportedTimestampFormat is returned when a SYLT frame with timestamps other than milliseconds
+// is exported to a timed-text format.
+var ErrUnsupportedTimestampFormat = errors.New("timestamps must be in milliseconds")
+
+// ErrInvalidCueTiming is returned when a cue timing line of a subtitle file can't be parsed.
+var ErrInvalidCueTiming = errors.New("invalid cue timing")
+
+// cueTimingPattern is a regex pattern to match cue timing lines (e.g., 00:00:01,000 --> 00:00:04,000).
+// Settings that follow the end time in WebVTT files are ignored.
+var cueTimingPattern = regexp.MustCompile(`^\s*(\S+)\s+-->\s+(\S+)`)
+
+// cueTimestampPattern is a regex pattern to match cue timestamps ([hh:]mm:ss,mmm or [hh:]mm:ss.mmm).
+var cueTimestampPattern = regexp.MustCompile(`^(?:(\d+):)?(\d{2}):(\d{2})[,.](\d{3})$`)
+
+// ParseSRTFile reads and parses a SubRip (.srt) subtitle file from the provided io.Reader.
+// Every cue becomes a synchronized text entry with the cue start time in milliseconds as the timestamp.
+// Multi-line cue texts are joined with new lines. The result can be used as SynchronizedTexts
+// of a SYLT frame with the SYLTAbsoluteMillisecondsTimestampFormat timestamp format.
+func ParseSRTFile(inputReader io.Reader) ([]SynchronizedText, error) {
+	return parseCues(inputReader)
+}
+
+// WriteSRT writes the SYLT frame as a SubRip (.srt) subtitle file to w.
+// Every entry becomes a cue ending where the next entry starts. The last cue lasts 3 seconds.
+// Returns ErrUnsupportedTimestampFormat if the timestamps aren't in milliseconds.
+func (sylf SynchronisedLyricsFrame) WriteSRT(w io.Writer) error {
+	if sylf.TimestampFormat != SYLTAbsoluteMillisecondsTimestampFormat {
+		return ErrUnsupportedTimestampFormat
+	}
+
+	bw := bufio.NewWriter(w)
+
+	for i, st := range sylf.SynchronizedTexts {
+		start, end := cueBounds(sylf.SynchronizedTexts, i)
+
+		fmt.Fprintf(bw, "%d\n%s --> %s\n%s\n\n", i+1,
+			formatCueTimestamp(start, ','), formatCueTimestamp(end, ','), st.Text)
+	}
+
+	return bw.Flush()
+}
+
+// parseCues parses the cues of SubRip and WebVTT files.
+// A cue is a block of lines separated by blank lines that contains a timing line,
+// optionally preceded by an identifier and followed by the text.
+// Blocks without a timing line (e.g., the WebVTT header or NOTE blocks) are skipped.
+func parseCues(inputReader io.Reader) ([]SynchronizedText, error) {
+	lines, err := readLinesFromReader(inputReader,
+		func(sourceLine string) (string, bool) {
+			// Remove the BOM and Windows line endings.
+			return strings.TrimSuffix(strings.TrimPrefix(sourceLine, "\uFEFF"), "\r"), false
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result    []SynchronizedText
+		current   *SynchronizedText
+		textLines []string
+	)
+
+	// flush adds the current cue to the result.
+	flush := func() {
+		if current != nil {
+			current.Text = strings.Join(textLines, "\n")
+			result = append(result, *current)
+		}
+
+		current, textLines = nil, nil
+	}
+
+	for _, line := range lines {
+		switch {
+		case strings.TrimSpace(line) == "":
+			flush()
+		case current == nil && strings.Contains(line, "-->"):
+			match := cueTimingPattern.FindStringSubmatch(line)
+			if len(match) != 3 {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidCueTiming, line)
+			}
+
+			start, ok := parseCueTimestamp(match[1])
+			if !ok {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidCueTiming, line)
+			}
+
+			current = &SynchronizedText{Timestamp: truncateInt64ToUint32(start)}
+		case current != nil:
+			textLines = append(textLines, line)
+		}
+	}
+
+	flush()
+
+	return result, nil
+}
+
+// parseCueTimestamp parses a cue timestamp ([hh:]mm:ss,mmm or [hh:]mm:ss.mmm) into milliseconds.
+func parseCueTimestamp(s string) (int64, bool) {
+	match := cueTimestampPattern.FindStringSubmatch(s)
+	if len(match) != 5 {
+		return 0, false
+	}
+
+	hours, _ := strconv.ParseInt("0"+match[1], 10, 64)
+	minutes, _ := strconv.ParseInt(match[2], 10, 64)
+	seconds, _ := strconv.ParseInt(match[3], 10, 64)
+	milliseconds, _ := strconv.ParseInt(match[4], 10, 64)
+
+	return ((hours*60+minutes)*60+seconds)*1000 + milliseconds, true
+}
+
+// formatCueTimestamp formats milliseconds as hh:mm:ss followed by the separator and milliseconds.
+func formatCueTimestamp(ms uint32, separator byte) string {
+	return fmt.Sprintf("%02d:%02d:%02d%c%03d", ms/3600000, ms/60000%60, ms/1000%60, separator, ms%1000)
+}
+
+// cueBounds returns the start and the end of the i-th entry in milliseconds.
+// The entry ends where the next one starts, the last entry lasts defaultCueDuration.
+func cueBounds(texts []SynchronizedText, i int) (uint32, uint32) {
+	start := texts[i].Timestamp
+	if i+1 < len(texts) && texts[i+1].Timestamp > start {
+		return start, texts[i+1].Timestamp
+	}
+
+	return start, truncateInt64ToUint32(int64(start) + defaultCueDuration)
+}
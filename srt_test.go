@@ -0,0 +1,76 @@
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSRTFile(t *testing.T) {
+	t.Parallel()
+
+	srtContent := "\uFEFF1\r\n00:00:01,500 --> 00:00:04,000\r\nFirst line\r\nSecond line\r\n\r\n" +
+		"2\n01:02:03,004 --> 01:02:05,000\nLast cue\n"
+
+	texts, err := ParseSRTFile(strings.NewReader(srtContent))
+	if err != nil {
+		t.Fatalf("Error parsing SRT file: %v", err)
+	}
+
+	expected := []SynchronizedText{
+		{Text: "First line\nSecond line", Timestamp: 1500},
+		{Text: "Last cue", Timestamp: 3723004},
+	}
+
+	if !reflect.DeepEqual(texts, expected) {
+		t.Errorf("Expected %v, got %v", expected, texts)
+	}
+}
+
+func TestParseSRTFileInvalidTiming(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseSRTFile(strings.NewReader("1\n00:01 --> 00:02\nText\n"))
+	if !errors.Is(err, ErrInvalidCueTiming) {
+		t.Errorf("Expected %v, got %v", ErrInvalidCueTiming, err)
+	}
+}
+
+func TestWriteSRT(t *testing.T) {
+	t.Parallel()
+
+	sylf := SynchronisedLyricsFrame{
+		TimestampFormat: SYLTAbsoluteMillisecondsTimestampFormat,
+		SynchronizedTexts: []SynchronizedText{
+			{Text: "Hello", Timestamp: 1000},
+			{Text: "World", Timestamp: 3723004},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	if err := sylf.WriteSRT(buf); err != nil {
+		t.Fatalf("Error writing SRT: %v", err)
+	}
+
+	expected := "1\n00:00:01,000 --> 01:02:03,004\nHello\n\n2\n01:02:03,004 --> 01:02:06,004\nWorld\n\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+
+	// The exported file must be parsed back to the same entries.
+	texts, err := ParseSRTFile(buf)
+	if err != nil {
+		t.Fatalf("Error parsing SRT file: %v", err)
+	}
+
+	if !reflect.DeepEqual(texts, sylf.SynchronizedTexts) {
+		t.Errorf("Expected %v, got %v", sylf.SynchronizedTexts, texts)
+	}
+
+	sylf.TimestampFormat = SYLTAbsoluteMpegFramesTimestampFormat
+	if err = sylf.WriteSRT(buf); !errors.Is(err, ErrUnsupportedTimestampFormat) {
+		t.Errorf("Expected %v, got %v", ErrUnsupportedTimestampFormat, err)
+	}
+}
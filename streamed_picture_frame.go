@@ -0,0 +1,72 @@
+package id3v2
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrPictureSizeMismatch is returned when the picture of a StreamedPictureFrame
+// has a size other than the declared one.
+var ErrPictureSizeMismatch = errors.New("written picture size doesn't match declared size")
+
+// StreamedPictureFrame is a picture frame (APIC) whose image data is produced at write time,
+// so generated artwork (e.g., waveform renders) can be attached without materializing it in memory first.
+// The size of the image must be known in advance, as it's written in the frame header before the image.
+// To add this frame to a tag, use the `tag.AddStreamedPicture` method.
+// Parsed tags contain regular PictureFrame frames.
+type StreamedPictureFrame struct {
+	Encoding     Encoding                // The text encoding used for the description.
+	MimeType     string                  // The MIME type of the image (e.g., "image/png").
+	PictureType  byte                    // The type of picture (e.g., front cover, back cover).
+	Description  string                  // A description of the picture.
+	PictureSize  int                     // The exact number of bytes WritePicture writes.
+	WritePicture func(w io.Writer) error // Writes the image data, it's called on every write of the tag.
+}
+
+// UniqueIdentifier returns the same identifier as a PictureFrame with the same type and description,
+// so both replace each other.
+func (spf StreamedPictureFrame) UniqueIdentifier() string {
+	return fmt.Sprintf("%02X%s", spf.PictureType, spf.Description)
+}
+
+// Size calculates the total size of the frame in bytes using the declared picture size.
+func (spf StreamedPictureFrame) Size() int {
+	return PictureFrame{
+		Encoding:    spf.Encoding,
+		MimeType:    spf.MimeType,
+		Description: spf.Description,
+	}.Size() + spf.PictureSize
+}
+
+// WriteTo writes the frame to the provided io.Writer, calling WritePicture for the image data.
+// It returns ErrPictureSizeMismatch if WritePicture writes a number of bytes other than PictureSize,
+// as the written tag would be corrupted otherwise.
+func (spf StreamedPictureFrame) WriteTo(w io.Writer) (n int64, err error) {
+	return useBufferedWriter(w, func(bw *bufferedWriter) error {
+		// Write the fields preceding the image data like a regular picture frame.
+		_, err = PictureFrame{
+			Encoding:    spf.Encoding,
+			MimeType:    spf.MimeType,
+			PictureType: spf.PictureType,
+			Description: spf.Description,
+		}.WriteTo(bw)
+		if err != nil {
+			return err
+		}
+
+		before := bw.Written()
+
+		if spf.WritePicture != nil {
+			if err = spf.WritePicture(bw); err != nil {
+				return err
+			}
+		}
+
+		if written := bw.Written() - before; written != spf.PictureSize {
+			return fmt.Errorf("%w: declared %d bytes, written %d", ErrPictureSizeMismatch, spf.PictureSize, written)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,65 @@
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestStreamedPictureFrame(t *testing.T) {
+	t.Parallel()
+
+	image := bytes.Repeat([]byte{0xAB}, 1000)
+
+	tag := NewEmptyTag()
+	tag.AddStreamedPicture(StreamedPictureFrame{
+		Encoding:    EncodingUTF8,
+		MimeType:    MimeTypePNG,
+		PictureType: PTFrontCover,
+		Description: "Waveform",
+		PictureSize: len(image),
+		WritePicture: func(w io.Writer) error {
+			// Write in chunks to simulate a renderer.
+			for i := 0; i < len(image); i += 100 {
+				if _, err := w.Write(image[i : i+100]); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	})
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatalf("Error serializing tag: %v", err)
+	}
+
+	parsed, err := ParseBytes(data, Options{Parse: true})
+	if err != nil {
+		t.Fatalf("Error parsing tag: %v", err)
+	}
+
+	pf, ok := parsed.GetLastFrame(parsed.CommonID("Attached picture")).(PictureFrame)
+	if !ok || !bytes.Equal(pf.Picture, image) || pf.Description != "Waveform" {
+		t.Errorf("Expected the streamed picture to be written, got %v", parsed.AllFrames())
+	}
+
+	tag.AddStreamedPicture(StreamedPictureFrame{
+		Encoding:    EncodingUTF8,
+		MimeType:    MimeTypePNG,
+		PictureType: PTFrontCover,
+		Description: "Waveform",
+		PictureSize: 10,
+		WritePicture: func(w io.Writer) error {
+			_, err := w.Write([]byte{1})
+
+			return err
+		},
+	})
+
+	if _, err = tag.Bytes(); !errors.Is(err, ErrPictureSizeMismatch) {
+		t.Errorf("Expected %v, got %v", ErrPictureSizeMismatch, err)
+	}
+}
@@ -0,0 +1,106 @@
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// StripTagStream copies the data read from r to w in a single pass, skipping a leading ID3v2 tag,
+// e.g. to compute checksums of the audio content only or to scrub metadata before sharing a file.
+// Data that doesn't start with an ID3v2 tag is copied as is. It returns the number of bytes written.
+func StripTagStream(r io.Reader, w io.Writer) (int64, error) {
+	return StripTagStreamWithOptions(r, w, SaveOptions{})
+}
+
+// StripTagStreamWithOptions copies the data read from r to w like StripTagStream, using the provided options.
+// With opts.StripTrailers a trailing ID3v1 tag is skipped as well, which is detected by holding back
+// the last 128 bytes until the end of the data. Lyrics3 blocks can't be found without seeking,
+// so they are copied. The other options are ignored.
+func StripTagStreamWithOptions(r io.Reader, w io.Writer, opts SaveOptions) (int64, error) {
+	if r == nil {
+		return 0, errors.New("r is nil")
+	}
+
+	prefix := make([]byte, tagHeaderSize)
+
+	n, err := io.ReadFull(r, prefix)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return 0, err
+	}
+
+	prefix = prefix[:n]
+
+	// Skip the tag, including its footer. Anything else is audio.
+	if header, headerErr := parseHeader(bytes.NewReader(prefix)); headerErr == nil {
+		if _, err = io.CopyN(io.Discard, r, header.info().TotalSize()-tagHeaderSize); err != nil {
+			if errors.Is(err, io.EOF) {
+				return 0, ErrTruncatedTag
+			}
+
+			return 0, err
+		}
+
+		prefix = nil
+	}
+
+	audio := io.MultiReader(bytes.NewReader(prefix), r)
+
+	buf := getByteSlice(defaultSaveBufferSize)
+	defer putByteSlice(buf)
+
+	if !opts.StripTrailers {
+		return io.CopyBuffer(w, audio, buf)
+	}
+
+	return copyWithoutID3v1(w, audio, buf)
+}
+
+// copyWithoutID3v1 copies the data read from r to w, except a trailing ID3v1 tag.
+// The last 128 bytes are held back in buf until the end of the data is reached.
+func copyWithoutID3v1(w io.Writer, r io.Reader, buf []byte) (int64, error) {
+	var (
+		written int64
+		held    int
+	)
+
+	for {
+		n, err := io.ReadFull(r, buf[held:])
+		held += n
+
+		// Write everything but the bytes that may be an ID3v1 tag.
+		if err == nil && held > id3v1TagSize {
+			m, writeErr := w.Write(buf[:held-id3v1TagSize])
+			written += int64(m)
+
+			if writeErr != nil {
+				return written, writeErr
+			}
+
+			held = copy(buf, buf[held-id3v1TagSize:held])
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			return written, err
+		}
+	}
+
+	tail := buf[:held]
+
+	hasID3v1, err := hasID3v1Tag(bytes.NewReader(tail), int64(len(tail)))
+	if err != nil {
+		return written, err
+	}
+
+	if hasID3v1 {
+		tail = tail[:len(tail)-id3v1TagSize]
+	}
+
+	m, err := w.Write(tail)
+
+	return written + int64(m), err
+}
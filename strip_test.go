@@ -0,0 +1,54 @@
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestStripTagStream(t *testing.T) {
+	t.Parallel()
+
+	source := NewEmptyTag()
+	source.SetTitle("Title")
+
+	var file bytes.Buffer
+	if _, err := source.WriteTo(&file); err != nil {
+		t.Fatal(err)
+	}
+
+	audio := makeMPEGFrames(500)
+	id3v1 := source.id3v1Tag().bytes()
+
+	file.Write(audio)
+	file.Write(id3v1)
+
+	testCases := []struct {
+		name     string
+		data     []byte
+		opts     SaveOptions
+		expected []byte
+	}{
+		{"tag", file.Bytes(), SaveOptions{}, concat(audio, id3v1)},
+		{"tag and ID3v1", file.Bytes(), SaveOptions{StripTrailers: true}, audio},
+		{"no tag", audio, SaveOptions{StripTrailers: true}, audio},
+		{"short", []byte("ID3"), SaveOptions{StripTrailers: true}, []byte("ID3")},
+	}
+
+	for _, tc := range testCases {
+		var out bytes.Buffer
+
+		n, err := StripTagStreamWithOptions(bytes.NewReader(tc.data), &out, tc.opts)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+
+		if n != int64(out.Len()) || !bytes.Equal(out.Bytes(), tc.expected) {
+			t.Errorf("%s: expected %d bytes, got %d bytes (%d reported)", tc.name, len(tc.expected), out.Len(), n)
+		}
+	}
+
+	if _, err := StripTagStream(bytes.NewReader(file.Bytes()[:20]), &bytes.Buffer{}); !errors.Is(err, ErrTruncatedTag) {
+		t.Errorf("Expected %v, got %v", ErrTruncatedTag, err)
+	}
+}
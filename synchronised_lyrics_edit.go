@@ -0,0 +1,80 @@
+package id3v2
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrMismatchedTimestampFormats is returned when SYLT frames with different timestamp formats are merged.
+var ErrMismatchedTimestampFormats = errors.New("SYLT frames have different timestamp formats")
+
+// MergeSynchronisedLyrics merges the frames (e.g., verses and chorus tracks) into a single frame
+// with the entries of all frames sorted by timestamp.
+// The encoding, language, content type and content descriptor are taken from the first frame.
+// It returns ErrMismatchedTimestampFormats if the frames have different timestamp formats,
+// convert them with ConvertTimestampFormat first.
+func MergeSynchronisedLyrics(frames ...SynchronisedLyricsFrame) (SynchronisedLyricsFrame, error) {
+	if len(frames) == 0 {
+		return SynchronisedLyricsFrame{}, nil
+	}
+
+	merged := frames[0]
+	merged.SynchronizedTexts = nil
+
+	for _, sylf := range frames {
+		if sylf.TimestampFormat != merged.TimestampFormat {
+			return SynchronisedLyricsFrame{}, fmt.Errorf("%w: %d and %d",
+				ErrMismatchedTimestampFormats, merged.TimestampFormat, sylf.TimestampFormat)
+		}
+
+		merged.SynchronizedTexts = append(merged.SynchronizedTexts, sylf.SynchronizedTexts...)
+	}
+
+	return merged.SortByTimestamp(), nil
+}
+
+// SplitByContentDescriptor groups the frames by their content descriptors,
+// e.g. to handle "Verse" and "Chorus" tracks separately.
+// The frames keep their order within a group.
+func SplitByContentDescriptor(frames []SynchronisedLyricsFrame) map[string][]SynchronisedLyricsFrame {
+	groups := make(map[string][]SynchronisedLyricsFrame)
+
+	for _, sylf := range frames {
+		groups[sylf.ContentDescriptor] = append(groups[sylf.ContentDescriptor], sylf)
+	}
+
+	return groups
+}
+
+// SortByTimestamp returns a copy of the frame with the entries sorted by timestamp.
+// Entries with equal timestamps keep their order.
+func (sylf SynchronisedLyricsFrame) SortByTimestamp() SynchronisedLyricsFrame {
+	sylf.SynchronizedTexts = slices.Clone(sylf.SynchronizedTexts)
+	slices.SortStableFunc(sylf.SynchronizedTexts, func(a, b SynchronizedText) int {
+		return cmp.Compare(a.Timestamp, b.Timestamp)
+	})
+
+	return sylf
+}
+
+// DeduplicateTimestamps returns a copy of the frame where only the first entry
+// of entries with identical timestamps is kept.
+func (sylf SynchronisedLyricsFrame) DeduplicateTimestamps() SynchronisedLyricsFrame {
+	seen := make(map[uint32]bool, len(sylf.SynchronizedTexts))
+	texts := make([]SynchronizedText, 0, len(sylf.SynchronizedTexts))
+
+	for _, st := range sylf.SynchronizedTexts {
+		if seen[st.Timestamp] {
+			continue
+		}
+
+		seen[st.Timestamp] = true
+		texts = append(texts, st)
+	}
+
+	sylf.SynchronizedTexts = texts
+
+	return sylf
+}
@@ -0,0 +1,89 @@
+package id3v2
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestMergeSynchronisedLyrics(t *testing.T) {
+	t.Parallel()
+
+	verses := SynchronisedLyricsFrame{
+		Language:          EnglishISO6392Code,
+		TimestampFormat:   SYLTAbsoluteMillisecondsTimestampFormat,
+		ContentDescriptor: "Verse",
+		SynchronizedTexts: []SynchronizedText{{Text: "Verse 1", Timestamp: 0}, {Text: "Verse 2", Timestamp: 2000}},
+	}
+	chorus := SynchronisedLyricsFrame{
+		Language:          EnglishISO6392Code,
+		TimestampFormat:   SYLTAbsoluteMillisecondsTimestampFormat,
+		ContentDescriptor: "Chorus",
+		SynchronizedTexts: []SynchronizedText{{Text: "Chorus", Timestamp: 1000}},
+	}
+
+	merged, err := MergeSynchronisedLyrics(verses, chorus)
+	if err != nil {
+		t.Fatalf("Error merging frames: %v", err)
+	}
+
+	expected := []SynchronizedText{
+		{Text: "Verse 1", Timestamp: 0},
+		{Text: "Chorus", Timestamp: 1000},
+		{Text: "Verse 2", Timestamp: 2000},
+	}
+	if !slices.Equal(merged.SynchronizedTexts, expected) {
+		t.Errorf("Expected %v, got %v", expected, merged.SynchronizedTexts)
+	}
+
+	if merged.ContentDescriptor != "Verse" {
+		t.Errorf("Expected descriptor %q, got %q", "Verse", merged.ContentDescriptor)
+	}
+
+	if len(verses.SynchronizedTexts) != 2 {
+		t.Errorf("Expected the first frame to be unchanged, got %v", verses.SynchronizedTexts)
+	}
+
+	chorus.TimestampFormat = SYLTAbsoluteMpegFramesTimestampFormat
+
+	if _, err = MergeSynchronisedLyrics(verses, chorus); !errors.Is(err, ErrMismatchedTimestampFormats) {
+		t.Errorf("Expected %v, got %v", ErrMismatchedTimestampFormats, err)
+	}
+
+	groups := SplitByContentDescriptor([]SynchronisedLyricsFrame{verses, chorus, verses})
+	if len(groups) != 2 || len(groups["Verse"]) != 2 || len(groups["Chorus"]) != 1 {
+		t.Errorf("Expected 2 verse frames and 1 chorus frame, got %v", groups)
+	}
+}
+
+func TestSynchronisedLyricsSortAndDeduplicate(t *testing.T) {
+	t.Parallel()
+
+	sylf := SynchronisedLyricsFrame{
+		SynchronizedTexts: []SynchronizedText{
+			{Text: "Two", Timestamp: 2000},
+			{Text: "One", Timestamp: 1000},
+			{Text: "Two again", Timestamp: 2000},
+		},
+	}
+
+	sorted := sylf.SortByTimestamp()
+
+	expected := []SynchronizedText{
+		{Text: "One", Timestamp: 1000},
+		{Text: "Two", Timestamp: 2000},
+		{Text: "Two again", Timestamp: 2000},
+	}
+	if !slices.Equal(sorted.SynchronizedTexts, expected) {
+		t.Errorf("Expected %v, got %v", expected, sorted.SynchronizedTexts)
+	}
+
+	if sylf.SynchronizedTexts[0].Text != "Two" {
+		t.Errorf("Expected the original frame to be unchanged, got %v", sylf.SynchronizedTexts)
+	}
+
+	deduplicated := sorted.DeduplicateTimestamps()
+	if !slices.Equal(deduplicated.SynchronizedTexts, expected[:2]) {
+		t.Errorf("Expected %v, got %v", expected[:2], deduplicated.SynchronizedTexts)
+	}
+}
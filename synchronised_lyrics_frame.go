@@ -1,9 +1,13 @@
 package id3v2
 
 import (
+	"cmp"
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"io"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -81,6 +85,12 @@ const (
 	LRCTagVersion  = "ve"     // The version of the program.
 )
 
+// ErrInvalidLRCLength is returned when the length metadata of an LRC file can't be parsed.
+var ErrInvalidLRCLength = errors.New("invalid LRC length")
+
+// lrcLengthPattern is a regex pattern to match the length metadata in LRC files (e.g., mm:ss or mm:ss.xx).
+var lrcLengthPattern = regexp.MustCompile(`^(\d+):(\d{2})(?:\.(\d{2,3}))?$`)
+
 var (
 	// ContentType maps content type constants to their human-readable descriptions.
 	ContentType = map[SYLTContentType]string{
@@ -101,8 +111,9 @@ var (
 	// SYLTOffsetMetadataPattern is a regex pattern to match the offset metadata in LRC files (e.g., [offset:+500]).
 	SYLTOffsetMetadataPattern = regexp.MustCompile(`^\[offset:([+-]?\d+)\]`)
 
-	// SYLTTimestampPattern is a regex pattern to match timestamps in LRC files (e.g., [mm:ss.xx]).
-	SYLTTimestampPattern = regexp.MustCompile(`\[(\d+):(\d{2})\.(\d{2})\](.*)`)
+	// SYLTTimestampPattern is a regex pattern to match a timestamp at the beginning of a line in LRC files
+	// (e.g., [mm:ss.xx], [mm:ss.xxx] or [hh:mm:ss.xx]).
+	SYLTTimestampPattern = regexp.MustCompile(`^\[(?:(\d+):)?(\d+):(\d{2})\.(\d{2,3})\]`)
 )
 
 // Size calculates the total size of the SYLT frame in bytes.
@@ -233,29 +244,20 @@ func ParseLRCFile(inputReader io.Reader) (ParseLRCFileParsingResult, error) {
 
 		// Check if the line contains metadata (e.g., [ar:Artist Name]).
 		metadataMatch := SYLTMetadataPattern.FindStringSubmatch(line)
-		// Check if the line contains a timestamp and lyrics (e.g., [01:23.45]Hello world).
-		timestampMatch := SYLTTimestampPattern.FindStringSubmatch(line)
+		// Check if the line contains timestamps and lyrics (e.g., [01:23.45]Hello world or [00:10.00][01:10.00]Chorus).
+		timestamps, lyric := parseLRCTimestamps(line)
 
 		switch {
-		case len(timestampMatch) == 5:
-			// Extract the timestamp components and lyrics.
-			minutes, _ := strconv.ParseInt(timestampMatch[1], 10, 0)
-			seconds, _ := strconv.ParseInt(timestampMatch[2], 10, 0)
-			hundredths, _ := strconv.ParseInt(timestampMatch[3], 10, 0)
-			lyric := strings.TrimSpace(timestampMatch[4])
-
-			// Convert the timestamp to milliseconds.
-			timestamp := minutes*60*1000 + seconds*1000 + hundredths*10
-
-			// Adjust the timestamp by the offset (if any).
-			timestamp += offset
-
-			// Add the synchronized lyrics to the result.
-			result.SynchronizedTexts = append(result.SynchronizedTexts,
-				SynchronizedText{
-					Text:      lyric,
-					Timestamp: truncateInt64ToUint32(timestamp),
-				})
+		case len(timestamps) > 0:
+			// Add the synchronized lyrics to the result once per timestamp,
+			// adjusting the timestamps by the offset (if any).
+			for _, timestamp := range timestamps {
+				result.SynchronizedTexts = append(result.SynchronizedTexts,
+					SynchronizedText{
+						Text:      strings.TrimSpace(lyric),
+						Timestamp: truncateInt64ToUint32(timestamp + offset),
+					})
+			}
 		case len(metadataMatch) == 3:
 			// Store metadata key-value pairs (e.g., [ar:Artist Name] -> "ar": "Artist Name").
 			result.Metadata[metadataMatch[1]] = metadataMatch[2]
@@ -265,9 +267,88 @@ func ParseLRCFile(inputReader io.Reader) (ParseLRCFileParsingResult, error) {
 		}
 	}
 
+	// Lines with several timestamps produce entries out of order, so sort them.
+	slices.SortStableFunc(result.SynchronizedTexts, func(a, b SynchronizedText) int {
+		return cmp.Compare(a.Timestamp, b.Timestamp)
+	})
+
 	return result, nil
 }
 
+// ApplyLRCMetadata fills in the basic frames of the tag that are missing from the metadata of the LRC file:
+// title (ti) becomes TIT2, artist (ar) becomes TPE1, album (al) becomes TALB
+// and length (length, mm:ss) becomes TLEN in milliseconds.
+// Frames already present in the tag aren't changed.
+// It returns ErrInvalidLRCLength if the length can't be parsed and ErrReadOnlyTag if the tag is read-only.
+func (tag *Tag) ApplyLRCMetadata(result ParseLRCFileParsingResult) error {
+	if tag.rejectChange() {
+		return ErrReadOnlyTag
+	}
+
+	if title := result.Metadata[LRCTagTitle]; title != "" && tag.Title() == "" {
+		tag.SetTitle(title)
+	}
+
+	if artist := result.Metadata[LRCTagArtist]; artist != "" && tag.Artist() == "" {
+		tag.SetArtist(artist)
+	}
+
+	if album := result.Metadata[LRCTagAlbum]; album != "" && tag.Album() == "" {
+		tag.SetAlbum(album)
+	}
+
+	length := result.Metadata[LRCTagLength]
+	if length == "" || tag.GetTextFrame(tag.CommonID("Length")).Text != "" {
+		return nil
+	}
+
+	match := lrcLengthPattern.FindStringSubmatch(strings.TrimSpace(length))
+	if match == nil {
+		return fmt.Errorf("%w: %q", ErrInvalidLRCLength, length)
+	}
+
+	minutes, _ := strconv.ParseInt(match[1], 10, 64)
+	seconds, _ := strconv.ParseInt(match[2], 10, 64)
+	fraction, _ := strconv.ParseInt(match[3], 10, 64)
+
+	if len(match[3]) == 2 {
+		fraction *= 10 // Convert hundredths to milliseconds.
+	}
+
+	ms := minutes*60*1000 + seconds*1000 + fraction
+	tag.AddTextFrame(tag.CommonID("Length"), tag.DefaultEncoding(), strconv.FormatInt(ms, 10))
+
+	return nil
+}
+
+// parseLRCTimestamps parses the timestamps at the beginning of the LRC line
+// and returns them in milliseconds along with the rest of the line.
+// Both two-digit (hundredths) and three-digit (milliseconds) fractions are supported.
+func parseLRCTimestamps(line string) ([]int64, string) {
+	var timestamps []int64
+
+	for {
+		match := SYLTTimestampPattern.FindStringSubmatch(line)
+		if match == nil {
+			return timestamps, line
+		}
+
+		// Extract the timestamp components, the hours are optional.
+		hours, _ := strconv.ParseInt(match[1], 10, 0)
+		minutes, _ := strconv.ParseInt(match[2], 10, 0)
+		seconds, _ := strconv.ParseInt(match[3], 10, 0)
+		fraction, _ := strconv.ParseInt(match[4], 10, 0)
+
+		if len(match[4]) == 2 {
+			fraction *= 10 // Convert hundredths to milliseconds.
+		}
+
+		// Convert the timestamp to milliseconds.
+		timestamps = append(timestamps, hours*60*60*1000+minutes*60*1000+seconds*1000+fraction)
+		line = line[len(match[0]):]
+	}
+}
+
 // parseSynchronisedLyricsFrame parses a SYLT frame from a bufferedReader.
 func parseSynchronisedLyricsFrame(br *bufferedReader, _ byte) (Framer, error) {
 	encoding := getEncoding(br.ReadByte())     // Read the encoding byte.
@@ -284,15 +365,20 @@ func parseSynchronisedLyricsFrame(br *bufferedReader, _ byte) (Framer, error) {
 
 	// Read each synchronized text entry until the end of the frame.
 	for {
-		textLyric, err := br.readTillDelimiters(encoding.TerminationBytes) // Read the text.
+		textLyric, err := br.readText(encoding) // Read the text and its termination bytes.
 		if err != nil {
 			break // Stop reading if we reach the end of the frame.
 		}
 
 		t := SynchronizedText{Text: decodeText(textLyric, encoding)} // Decode the text.
-		br.Next(len(encoding.TerminationBytes))                      // Skip the text termination bytes.
 
-		timeStamp := br.Next(4)                             // Read the timestamp.
+		timeStamp := br.Next(4) // Read the timestamp.
+		if len(timeStamp) < 4 {
+			// The frame ends in the middle of the timestamp. The frame is rejected,
+			// or skipped in lenient mode.
+			return nil, io.ErrUnexpectedEOF
+		}
+
 		timeStampUint := binary.BigEndian.Uint32(timeStamp) // Convert the timestamp to uint32.
 		t.Timestamp = timeStampUint
 
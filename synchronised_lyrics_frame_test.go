@@ -2,7 +2,10 @@ package id3v2
 
 import (
 	"bytes"
+	"errors"
+	"io"
 	"os"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -86,6 +89,37 @@ func TestParseLRCFile(t *testing.T) {
 	}
 }
 
+func TestParseLRCFileTimestampVariants(t *testing.T) {
+	t.Parallel()
+
+	lrcContent := `
+[00:10.00][01:10.00]Chorus
+[00:20.123]Three-digit fraction
+[01:02:03.45]With hours
+[ar:Artist Name]
+`
+
+	result, err := ParseLRCFile(strings.NewReader(lrcContent))
+	if err != nil {
+		t.Fatalf("Error parsing LRC file: %v", err)
+	}
+
+	expectedLyrics := []SynchronizedText{
+		{Text: "Chorus", Timestamp: 10000},
+		{Text: "Three-digit fraction", Timestamp: 20123},
+		{Text: "Chorus", Timestamp: 70000},
+		{Text: "With hours", Timestamp: 3723450},
+	}
+
+	if !slices.Equal(result.SynchronizedTexts, expectedLyrics) {
+		t.Errorf("Expected %v, got %v", expectedLyrics, result.SynchronizedTexts)
+	}
+
+	if result.Metadata[LRCTagArtist] != "Artist Name" {
+		t.Errorf("Expected artist metadata 'Artist Name', got '%s'", result.Metadata[LRCTagArtist])
+	}
+}
+
 func TestSynchronisedLyricsFrameWriteTo(t *testing.T) {
 	sylf := SynchronisedLyricsFrame{
 		Encoding:          EncodingUTF8,
@@ -304,3 +338,71 @@ func TestAddSynchronisedLyricsFrame(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyLRCMetadata(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetArtist("Existing Artist")
+
+	result := ParseLRCFileParsingResult{
+		Metadata: map[string]string{
+			LRCTagTitle:  "Title",
+			LRCTagArtist: "Artist Name",
+			LRCTagAlbum:  "Album Name",
+			LRCTagLength: "03:30.50",
+		},
+	}
+
+	if err := tag.ApplyLRCMetadata(result); err != nil {
+		t.Fatalf("Error applying LRC metadata: %v", err)
+	}
+
+	if tag.Title() != "Title" {
+		t.Errorf("Expected title %q, got %q", "Title", tag.Title())
+	}
+
+	if tag.Artist() != "Existing Artist" {
+		t.Errorf("Expected artist %q, got %q", "Existing Artist", tag.Artist())
+	}
+
+	if tag.Album() != "Album Name" {
+		t.Errorf("Expected album %q, got %q", "Album Name", tag.Album())
+	}
+
+	if length := tag.GetTextFrame(tag.CommonID("Length")).Text; length != "210500" {
+		t.Errorf("Expected length %q, got %q", "210500", length)
+	}
+
+	result.Metadata[LRCTagLength] = "3 minutes"
+
+	if err := NewEmptyTag().ApplyLRCMetadata(result); !errors.Is(err, ErrInvalidLRCLength) {
+		t.Errorf("Expected %v, got %v", ErrInvalidLRCLength, err)
+	}
+}
+
+// TestParseSynchronisedLyricsFrameTruncatedTimestamp checks that a SYLT frame cut off in a timestamp is rejected.
+func TestParseSynchronisedLyricsFrameTruncatedTimestamp(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.AddFrame("SYLT", UnknownFrame{Body: []byte{0, 'e', 'n', 'g', 2, 1, 0, 'a', 0, 0, 1}})
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = ParseBytes(data, Options{Parse: true}); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Expected %v, got %v", io.ErrUnexpectedEOF, err)
+	}
+
+	parsed, err := ParseBytes(data, Options{Parse: true, Lenient: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parsed.Count() != 0 || len(parsed.ParseWarnings()) != 1 {
+		t.Errorf("Expected the frame to be skipped with a warning, got %d frames and %v", parsed.Count(), parsed.ParseWarnings())
+	}
+}
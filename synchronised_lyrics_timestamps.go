@@ -0,0 +1,106 @@
+package id3v2
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// ErrInvalidFrameDuration is returned when timestamps are converted with a non-positive MPEG frame duration.
+var ErrInvalidFrameDuration = errors.New("MPEG frame duration must be positive")
+
+// ConvertTimestampFormat returns a copy of the frame with timestamps converted to the given format.
+// Conversion between SYLTAbsoluteMpegFramesTimestampFormat and SYLTAbsoluteMillisecondsTimestampFormat
+// requires the duration of a single MPEG frame, see AudioInfo.FrameDuration.
+// Millisecond timestamps are rounded to the nearest frame.
+// It returns ErrUnsupportedTimestampFormat if either format is unknown.
+func (sylf SynchronisedLyricsFrame) ConvertTimestampFormat(
+	to SYLTTimestampFormat,
+	frameDuration time.Duration,
+) (SynchronisedLyricsFrame, error) {
+	if sylf.TimestampFormat == to {
+		return sylf, nil
+	}
+
+	if !isKnownTimestampFormat(sylf.TimestampFormat) || !isKnownTimestampFormat(to) {
+		return sylf, fmt.Errorf("%w: can't convert from %d to %d", ErrUnsupportedTimestampFormat, sylf.TimestampFormat, to)
+	}
+
+	if frameDuration <= 0 {
+		return sylf, ErrInvalidFrameDuration
+	}
+
+	texts := make([]SynchronizedText, len(sylf.SynchronizedTexts))
+
+	for i, st := range sylf.SynchronizedTexts {
+		var timestamp float64
+		if to == SYLTAbsoluteMillisecondsTimestampFormat {
+			timestamp = float64(st.Timestamp) * float64(frameDuration) / float64(time.Millisecond)
+		} else {
+			timestamp = float64(st.Timestamp) * float64(time.Millisecond) / float64(frameDuration)
+		}
+
+		texts[i] = SynchronizedText{Text: st.Text, Timestamp: clampTimestamp(math.Round(timestamp))}
+	}
+
+	sylf.TimestampFormat = to
+	sylf.SynchronizedTexts = texts
+
+	return sylf, nil
+}
+
+// ShiftTimestamps returns a copy of the frame with all timestamps moved by delta,
+// e.g. to apply a lyrics offset after the fact. Timestamps are clamped at zero.
+// Only millisecond timestamps can be shifted, convert MPEG frame timestamps with ConvertTimestampFormat first.
+// It returns ErrUnsupportedTimestampFormat for other formats.
+func (sylf SynchronisedLyricsFrame) ShiftTimestamps(delta time.Duration) (SynchronisedLyricsFrame, error) {
+	if sylf.TimestampFormat != SYLTAbsoluteMillisecondsTimestampFormat {
+		return sylf, ErrUnsupportedTimestampFormat
+	}
+
+	texts := make([]SynchronizedText, len(sylf.SynchronizedTexts))
+
+	for i, st := range sylf.SynchronizedTexts {
+		texts[i] = SynchronizedText{
+			Text:      st.Text,
+			Timestamp: clampTimestamp(float64(st.Timestamp) + float64(delta.Milliseconds())),
+		}
+	}
+
+	sylf.SynchronizedTexts = texts
+
+	return sylf, nil
+}
+
+// isKnownTimestampFormat reports whether the timestamps in the format can be converted.
+func isKnownTimestampFormat(format SYLTTimestampFormat) bool {
+	return format == SYLTAbsoluteMpegFramesTimestampFormat || format == SYLTAbsoluteMillisecondsTimestampFormat
+}
+
+// clampTimestamp converts the value to a timestamp, clamping it to the range of uint32.
+func clampTimestamp(value float64) uint32 {
+	return uint32(min(max(value, 0), math.MaxUint32))
+}
+
+// TextAt returns the entry displayed at the playback time d and its index,
+// i.e. the last entry whose timestamp isn't after d. The entries must be sorted, see SortByTimestamp.
+// It returns -1 if d is before the first entry or the timestamps aren't in milliseconds.
+func (sylf SynchronisedLyricsFrame) TextAt(d time.Duration) (SynchronizedText, int) {
+	if sylf.TimestampFormat != SYLTAbsoluteMillisecondsTimestampFormat {
+		return SynchronizedText{}, -1
+	}
+
+	ms := d.Milliseconds()
+
+	// Find the first entry after d, the previous one is displayed.
+	i := sort.Search(len(sylf.SynchronizedTexts), func(i int) bool {
+		return int64(sylf.SynchronizedTexts[i].Timestamp) > ms
+	})
+	if i == 0 {
+		return SynchronizedText{}, -1
+	}
+
+	return sylf.SynchronizedTexts[i-1], i - 1
+}
@@ -0,0 +1,122 @@
+package id3v2
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSynchronisedLyricsConvertTimestampFormat(t *testing.T) {
+	t.Parallel()
+
+	frameDuration := AudioInfo{Version: MPEGVersion1, Layer: 3, SampleRate: 44100}.FrameDuration()
+	if frameDuration != 26122448*time.Nanosecond {
+		t.Fatalf("Expected frame duration %v, got %v", 26122448*time.Nanosecond, frameDuration)
+	}
+
+	sylf := SynchronisedLyricsFrame{
+		TimestampFormat:   SYLTAbsoluteMpegFramesTimestampFormat,
+		SynchronizedTexts: []SynchronizedText{{Text: "One", Timestamp: 0}, {Text: "Two", Timestamp: 383}},
+	}
+
+	converted, err := sylf.ConvertTimestampFormat(SYLTAbsoluteMillisecondsTimestampFormat, frameDuration)
+	if err != nil {
+		t.Fatalf("Error converting timestamps: %v", err)
+	}
+
+	if converted.TimestampFormat != SYLTAbsoluteMillisecondsTimestampFormat {
+		t.Errorf("Expected format %v, got %v", SYLTAbsoluteMillisecondsTimestampFormat, converted.TimestampFormat)
+	}
+
+	if got := converted.SynchronizedTexts[1].Timestamp; got != 10005 {
+		t.Errorf("Expected timestamp 10005, got %v", got)
+	}
+
+	if sylf.SynchronizedTexts[1].Timestamp != 383 {
+		t.Errorf("Expected the original frame to be unchanged, got %v", sylf.SynchronizedTexts[1].Timestamp)
+	}
+
+	back, err := converted.ConvertTimestampFormat(SYLTAbsoluteMpegFramesTimestampFormat, frameDuration)
+	if err != nil {
+		t.Fatalf("Error converting timestamps back: %v", err)
+	}
+
+	if got := back.SynchronizedTexts[1].Timestamp; got != 383 {
+		t.Errorf("Expected timestamp 383, got %v", got)
+	}
+
+	_, err = sylf.ConvertTimestampFormat(SYLTAbsoluteMillisecondsTimestampFormat, 0)
+	if !errors.Is(err, ErrInvalidFrameDuration) {
+		t.Errorf("Expected %v, got %v", ErrInvalidFrameDuration, err)
+	}
+
+	_, err = sylf.ConvertTimestampFormat(SYLTTimestampFormat(9), frameDuration)
+	if !errors.Is(err, ErrUnsupportedTimestampFormat) {
+		t.Errorf("Expected %v, got %v", ErrUnsupportedTimestampFormat, err)
+	}
+}
+
+func TestSynchronisedLyricsShiftTimestamps(t *testing.T) {
+	t.Parallel()
+
+	sylf := SynchronisedLyricsFrame{
+		TimestampFormat:   SYLTAbsoluteMillisecondsTimestampFormat,
+		SynchronizedTexts: []SynchronizedText{{Text: "One", Timestamp: 200}, {Text: "Two", Timestamp: 1500}},
+	}
+
+	shifted, err := sylf.ShiftTimestamps(-500 * time.Millisecond)
+	if err != nil {
+		t.Fatalf("Error shifting timestamps: %v", err)
+	}
+
+	if got := shifted.SynchronizedTexts[0].Timestamp; got != 0 {
+		t.Errorf("Expected timestamp 0, got %v", got)
+	}
+
+	if got := shifted.SynchronizedTexts[1].Timestamp; got != 1000 {
+		t.Errorf("Expected timestamp 1000, got %v", got)
+	}
+
+	sylf.TimestampFormat = SYLTAbsoluteMpegFramesTimestampFormat
+
+	if _, err = sylf.ShiftTimestamps(time.Second); !errors.Is(err, ErrUnsupportedTimestampFormat) {
+		t.Errorf("Expected %v, got %v", ErrUnsupportedTimestampFormat, err)
+	}
+}
+
+func TestSynchronisedLyricsTextAt(t *testing.T) {
+	t.Parallel()
+
+	sylf := SynchronisedLyricsFrame{
+		TimestampFormat: SYLTAbsoluteMillisecondsTimestampFormat,
+		SynchronizedTexts: []SynchronizedText{
+			{Text: "One", Timestamp: 1000},
+			{Text: "Two", Timestamp: 2000},
+			{Text: "Three", Timestamp: 3000},
+		},
+	}
+
+	testCases := []struct {
+		at       time.Duration
+		text     string
+		expected int
+	}{
+		{at: 500 * time.Millisecond, text: "", expected: -1},
+		{at: time.Second, text: "One", expected: 0},
+		{at: 2999 * time.Millisecond, text: "Two", expected: 1},
+		{at: time.Hour, text: "Three", expected: 2},
+	}
+
+	for _, tc := range testCases {
+		st, i := sylf.TextAt(tc.at)
+		if i != tc.expected || st.Text != tc.text {
+			t.Errorf("At %v: expected %q (%d), got %q (%d)", tc.at, tc.text, tc.expected, st.Text, i)
+		}
+	}
+
+	sylf.TimestampFormat = SYLTAbsoluteMpegFramesTimestampFormat
+
+	if _, i := sylf.TextAt(time.Hour); i != -1 {
+		t.Errorf("Expected -1 for MPEG frame timestamps, got %d", i)
+	}
+}
@@ -0,0 +1,190 @@
+package id3v2
+
+import (
+	"errors"
+	"io"
+)
+
+const (
+	// defaultTableOfContentsElementID is the element ID of tables of contents created by converters.
+	defaultTableOfContentsElementID = "toc"
+
+	// defaultChapterElementIDPrefix is the prefix of element IDs of chapters created by converters.
+	defaultChapterElementIDPrefix = "chp"
+)
+
+// Flags of the table of contents frame.
+const (
+	tocFlagOrdered  = 0x01 // The child elements are ordered.
+	tocFlagTopLevel = 0x02 // The frame is the root of the table of contents tree.
+)
+
+// TableOfContentsFrame represents a table of contents frame (CTOC) in an ID3v2 tag,
+// as defined by the ID3v2 chapters specification here - http://id3.org/id3v2-chapters-1.0.
+// It lists the element IDs of chapter frames (or nested tables of contents)
+// and supports TIT2 (Title field) and TIT3 (Description field) subframes, other subframes are ignored.
+//
+// To add a table of contents frame to a tag, use the `tag.AddTableOfContentsFrame()` method.
+type TableOfContentsFrame struct {
+	ElementID       string     // Unique identifier for the table of contents.
+	TopLevel        bool       // Whether this is the root of the table of contents tree.
+	Ordered         bool       // Whether the child elements are ordered.
+	ChildElementIDs []string   // Element IDs of the chapters or nested tables of contents.
+	Title           *TextFrame // Title of the table of contents (optional).
+	Description     *TextFrame // Description of the table of contents (optional).
+}
+
+// Size calculates the total size of the TableOfContentsFrame in bytes, including all its subframes.
+func (tocf TableOfContentsFrame) Size() int {
+	size := encodedSize(tocf.ElementID, EncodingISO) +
+		1 + // Trailing zero after ElementID.
+		1 + // Flags.
+		1 // Entry count.
+
+	for _, childID := range tocf.ChildElementIDs {
+		size += encodedSize(childID, EncodingISO) + 1 // Child element ID and its trailing zero.
+	}
+
+	if tocf.Title != nil {
+		size += frameHeaderSize + tocf.Title.Size() // Add size of the Title frame.
+	}
+
+	if tocf.Description != nil {
+		size += frameHeaderSize + tocf.Description.Size() // Add size of the Description frame.
+	}
+
+	return size
+}
+
+// UniqueIdentifier returns the unique identifier for the TableOfContentsFrame, which is its ElementID.
+func (tocf TableOfContentsFrame) UniqueIdentifier() string {
+	return tocf.ElementID
+}
+
+// WriteTo writes the TableOfContentsFrame to the provided io.Writer, including all its subframes.
+func (tocf TableOfContentsFrame) WriteTo(w io.Writer) (n int64, err error) {
+	// The entry count is stored in a single byte.
+	if len(tocf.ChildElementIDs) > 0xFF {
+		return 0, ErrTooManyChildElements
+	}
+
+	return useBufferedWriter(w, func(bw *bufferedWriter) error {
+		// Write the ElementID in ISO encoding, followed by a null terminator.
+		bw.EncodeAndWriteText(tocf.ElementID, EncodingISO)
+		bw.WriteByte(0)
+
+		// Write the flags.
+		var flags byte
+		if tocf.Ordered {
+			flags |= tocFlagOrdered
+		}
+
+		if tocf.TopLevel {
+			flags |= tocFlagTopLevel
+		}
+
+		bw.WriteByte(flags)
+
+		// Write the entry count and the child element IDs.
+		bw.WriteByte(byte(len(tocf.ChildElementIDs)))
+
+		for _, childID := range tocf.ChildElementIDs {
+			bw.EncodeAndWriteText(childID, EncodingISO)
+			bw.WriteByte(0)
+		}
+
+		// Write the Title frame if it exists.
+		if tocf.Title != nil {
+			err = writeFrame(bw, TitleFrameID, *tocf.Title, true)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Write the Description frame if it exists.
+		if tocf.Description != nil {
+			err = writeFrame(bw, SubtitleRefinementFrameID, *tocf.Description, true)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ErrTooManyChildElements is returned when a table of contents frame has more than 255 child elements.
+var ErrTooManyChildElements = errors.New("table of contents can't have more than 255 child elements")
+
+// parseTableOfContentsFrame parses a TableOfContentsFrame from a bufferedReader.
+func parseTableOfContentsFrame(br *bufferedReader, version byte) (Framer, error) {
+	tocf := TableOfContentsFrame{
+		ElementID: string(br.ReadText(EncodingISO)),
+	}
+
+	// Read the flags and the entry count.
+	flags := br.ReadByte()
+	entryCount := int(br.ReadByte())
+
+	if br.Err() != nil {
+		return nil, br.Err()
+	}
+
+	tocf.Ordered = flags&tocFlagOrdered != 0
+	tocf.TopLevel = flags&tocFlagTopLevel != 0
+
+	// Read the child element IDs.
+	tocf.ChildElementIDs = make([]string, 0, entryCount)
+	for range entryCount {
+		childID := br.ReadText(EncodingISO)
+		if br.Err() != nil {
+			return nil, br.Err()
+		}
+
+		tocf.ChildElementIDs = append(tocf.ChildElementIDs, string(childID))
+	}
+
+	buf := getByteSlice(defaultBufferSize)
+	defer putByteSlice(buf) // Return the buffer to the pool when done.
+
+	// Parse subframes until the end of the frame.
+	for {
+		header, err := parseFrameHeader(buf, br, version == 4)
+		if errors.Is(err, io.EOF) || errors.Is(err, ErrBlankFrame) || errors.Is(err, ErrInvalidSizeFormat) {
+			break // Stop parsing if we reach the end or encounter an invalid frame.
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		bodyReader := getLimitedReader(br, header.BodySize)
+
+		// Only Title and Description subframes are supported, others are skipped.
+		if header.ID == TitleFrameID || header.ID == SubtitleRefinementFrameID {
+			frame, err := parseTextFrame(newBufferedReader(bodyReader)) //nolint:govet // Shadowing is intended.
+			if err != nil {
+				putLimitedReader(bodyReader)
+
+				return nil, err
+			}
+
+			tf, _ := frame.(TextFrame)
+			if header.ID == TitleFrameID {
+				tocf.Title = &tf
+			} else {
+				tocf.Description = &tf
+			}
+		}
+
+		err = skipReaderBuf(bodyReader, buf)
+
+		putLimitedReader(bodyReader)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tocf, nil
+}
@@ -0,0 +1,56 @@
+package id3v2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTableOfContentsFrameRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, version := range []byte{3, 4} {
+		tag := NewEmptyTag()
+		tag.SetVersion(version)
+
+		tocf := TableOfContentsFrame{
+			ElementID:       "toc",
+			TopLevel:        true,
+			Ordered:         true,
+			ChildElementIDs: []string{"chp1", "chp2"},
+			Title:           &TextFrame{Encoding: EncodingUTF8, Text: "Contents"},
+		}
+
+		tag.AddTableOfContentsFrame(tocf)
+
+		data, err := tag.Bytes()
+		if err != nil {
+			t.Fatalf("Error while serializing tag: %v", err)
+		}
+
+		if len(data) != tag.Size() {
+			t.Errorf("Expected size %v, got %v", tag.Size(), len(data))
+		}
+
+		parsed, err := ParseBytes(data, parseOpts)
+		if err != nil {
+			t.Fatalf("Error while parsing tag: %v", err)
+		}
+
+		frames := parsed.GetFrames(parsed.CommonID("Table of contents"))
+		if len(frames) != 1 {
+			t.Fatalf("Expected 1 table of contents frame, got %v", len(frames))
+		}
+
+		got, ok := frames[0].(TableOfContentsFrame)
+		if !ok {
+			t.Fatalf("Expected TableOfContentsFrame, got %T", frames[0])
+		}
+
+		// Parsed text frames are multi-value.
+		tocf.Title.Multi = []string{"Contents"}
+
+		if !reflect.DeepEqual(got, tocf) {
+			t.Errorf("Version %v: expected %+v, got %+v", version, tocf, got)
+		}
+	}
+}
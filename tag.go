@@ -1,10 +1,16 @@
 package id3v2
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"io"
+	"io/fs"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 
 	"code.cloudfoundry.org/bytefmt"
 )
@@ -17,6 +23,17 @@ const defaultSaveBufferSize = 128 * bytefmt.KILOBYTE
 // For example, if you try to save or close a tag that was created without a file.
 var ErrNoFile = errors.New("tag was not initialized with file")
 
+// ErrNoPicture is returned when a picture is requested from a tag without attached pictures.
+var ErrNoPicture = errors.New("tag has no attached pictures")
+
+// ErrReadOnlyFS is returned when saving a tag that was opened with OpenFS.
+// fs.FS provides no way to write files back, so such tags can only be serialized with WriteTo.
+var ErrReadOnlyFS = errors.New("tag was opened from a read-only file system")
+
+// ErrReadOnlyTag is returned when saving, deleting or resetting a tag marked read-only
+// with Options.ReadOnly or SetReadOnly, and by tag.Err after a change of such a tag was rejected.
+var ErrReadOnlyTag = errors.New("tag is read-only")
+
 // Tag represents an ID3v2 tag in an MP3 file. It stores all the metadata frames, sequences, and other
 // relevant information about the tag. You can use it to read, modify, or create ID3v2 tags.
 type Tag struct {
@@ -25,15 +42,44 @@ type Tag struct {
 
 	defaultEncoding Encoding  // The default text encoding used for text frames.
 	reader          io.Reader // The reader for the MP3 file.
+	fsys            fs.FS     // The file system the file was opened from with OpenFS, if any.
+	offset          int64     // The offset of the tag in the file, non-zero if it follows junk bytes.
+	container       Container // The type of file the tag is stored in.
 	originalSize    int64     // The original size of the tag in bytes.
 	version         byte      // The ID3v2 version (e.g., 3 or 4).
+	header          Info      // The header of the tag as read from the file.
+
+	parseWarnings     []ParseWarning // The frames skipped while parsing in lenient mode.
+	parseStats        ParseStats     // What was left out while parsing.
+	rawFrames         []RawFrame     // The original bytes of the parsed frames.
+	damagedRanges     []DamagedRange // The byte ranges that couldn't be interpreted while parsing.
+	nonSynchsafeSizes bool           // Whether the ID3v2.4 tag was read with plain integer frame sizes.
+	modified          bool           // Whether frames were added, removed or changed since parsing or saving.
+	saves             int            // The number of times the tag was saved, see Snapshot.
+	readOnly          bool           // Whether changes of the frames are rejected.
+	err               error          // ErrReadOnlyTag if a change was rejected, see Err.
+
+	writeOptions       WriteOptions // The settings used when the tag is serialized.
+	headerFlags        HeaderFlags  // The flags of the tag header written by WriteTo.
+	singlePictureTypes bool         // Whether AddAttachedPicture keeps only one picture of restricted types.
+	normalizedTXXX     bool         // Whether user-defined text frames are matched by normalized descriptions.
+	popularimeterEmail string       // The identity used by the rating and play count methods.
+
+	frameAddedObservers   []FrameObserver // The observers registered with OnFrameAdded.
+	frameDeletedObservers []FrameObserver // The observers registered with OnFrameDeleted.
+
+	textNormalizers []TextNormalizer // The normalizers applied to text frames on write.
+	truncations     []TextTruncation // The values truncated by the last write.
+
+	commonIDAliases map[string]string          // The custom descriptions set with SetCommonIDAlias.
+	sequenceKeys    map[string]SequenceKeyFunc // The custom sequence keys set with SetSequenceKey.
 }
 
 // AddFrame adds a frame to the tag with the specified ID. If the ID is empty or the frame is nil,
 // the function does nothing. For frames that can appear multiple times (e.g., pictures or comments),
 // use the specialized methods like AddAttachedPicture, AddCommentFrame or AddUnsynchronisedLyricsFrame.
 func (tag *Tag) AddFrame(id string, f Framer) {
-	if id == "" || f == nil {
+	if id == "" || f == nil || tag.rejectChange() {
 		return
 	}
 
@@ -43,16 +89,94 @@ func (tag *Tag) AddFrame(id string, f Framer) {
 			sequence = getSequence()
 		}
 
-		sequence.AddFrame(f)
+		replaced := sequence.addFrameWithKey(f, tag.sequenceKey(id))
 		tag.sequences[id] = sequence
+		tag.track(id, replaced, f)
 	} else {
-		tag.frames[id] = f
+		tag.frames[id] = tag.track(id, tag.frames[id], f)
 	}
 }
 
 // AddAttachedPicture adds a picture frame (e.g., album art) to the tag.
+// Pictures with the same type and description replace each other.
+// If SetSinglePictureTypes is enabled, a picture of a type that may appear only once
+// (file icons, front and back covers) replaces any picture of the same type.
 func (tag *Tag) AddAttachedPicture(pf PictureFrame) {
-	tag.AddFrame(tag.CommonID("Attached picture"), pf)
+	id := tag.CommonID("Attached picture")
+
+	if tag.singlePictureTypes && isSinglePictureType(pf.PictureType) {
+		tag.deleteFramesFunc(id, func(f Framer) bool {
+			existing, ok := f.(PictureFrame)
+
+			return ok && existing.PictureType == pf.PictureType
+		})
+	}
+
+	tag.AddFrame(id, pf)
+}
+
+// AddStreamedPicture adds a picture frame whose image data is produced at write time to the tag.
+// It replaces pictures with the same type and description like AddAttachedPicture,
+// but SetSinglePictureTypes isn't enforced.
+func (tag *Tag) AddStreamedPicture(spf StreamedPictureFrame) {
+	tag.AddFrame(tag.CommonID("Attached picture"), spf)
+}
+
+// SetSinglePictureTypes sets whether AddAttachedPicture enforces a single picture
+// of the types that may appear only once in a tag: PTFileIcon, PTOtherFileIcon, PTFrontCover and PTBackCover.
+// It's disabled by default, so pictures of the same type with different descriptions are kept,
+// as many taggers write them.
+func (tag *Tag) SetSinglePictureTypes(enabled bool) {
+	tag.singlePictureTypes = enabled
+}
+
+// isSinglePictureType reports whether only one picture of the type may appear in a tag.
+func isSinglePictureType(pictureType byte) bool {
+	switch pictureType {
+	case PTFileIcon, PTOtherFileIcon, PTFrontCover, PTBackCover:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExtractFrontCover writes the image data of the front cover to the file at path.
+// If path has no extension, the one matching the picture's MIME type is appended (see PictureFrame.Extension).
+// If there is no front cover, the first attached picture is used.
+// It returns the path of the written file, or ErrNoPicture if the tag has no attached pictures.
+func (tag *Tag) ExtractFrontCover(path string) (string, error) {
+	pictures := tag.GetFrames(tag.CommonID("Attached picture"))
+	if len(pictures) == 0 {
+		return "", ErrNoPicture
+	}
+
+	cover, _ := pictures[0].(PictureFrame)
+
+	for _, f := range pictures {
+		if pf, ok := f.(PictureFrame); ok && pf.PictureType == PTFrontCover {
+			cover = pf
+
+			break
+		}
+	}
+
+	if filepath.Ext(path) == "" {
+		path += cover.Extension()
+	}
+
+	file, err := os.Create(filepath.Clean(path))
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = cover.WriteImageTo(file); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+
+		return "", err
+	}
+
+	return path, file.Close()
 }
 
 // AddChapterFrame adds a chapter frame to the tag. Chapters are used to divide an audio file into sections.
@@ -60,6 +184,12 @@ func (tag *Tag) AddChapterFrame(cf ChapterFrame) {
 	tag.AddFrame(tag.CommonID("Chapters"), cf)
 }
 
+// AddTableOfContentsFrame adds a table of contents frame (CTOC) to the tag.
+// It lists the chapters added with AddChapterFrame.
+func (tag *Tag) AddTableOfContentsFrame(tocf TableOfContentsFrame) {
+	tag.AddFrame(tag.CommonID("Table of contents"), tocf)
+}
+
 // AddCommentFrame adds a comment frame to the tag. Comments can include a description and text.
 func (tag *Tag) AddCommentFrame(cf CommentFrame) {
 	tag.AddFrame(tag.CommonID("Comments"), cf)
@@ -84,32 +214,213 @@ func (tag *Tag) AddSynchronisedLyricsFrame(sylf SynchronisedLyricsFrame) {
 
 // AddUserDefinedTextFrame adds a user-defined text frame (TXXX) to the tag.
 // These frames allow custom metadata to be stored.
+// If SetNormalizedDescriptions is enabled, the frame replaces any frame
+// whose description differs only in case or surrounding spaces.
 func (tag *Tag) AddUserDefinedTextFrame(udtf UserDefinedTextFrame) {
-	tag.AddFrame(tag.CommonID("User defined text information frame"), udtf)
+	id := tag.CommonID("User defined text information frame")
+
+	if tag.normalizedTXXX {
+		description := normalizeDescription(udtf.Description)
+
+		tag.deleteFramesFunc(id, func(f Framer) bool {
+			existing, ok := f.(UserDefinedTextFrame)
+
+			return ok && normalizeDescription(existing.Description) == description
+		})
+	}
+
+	tag.AddFrame(id, udtf)
+}
+
+// SetUserDefinedText replaces the user-defined text frames (TXXX) with the description
+// with a single frame holding the values in the default encoding of the tag.
+// Multiple values are written separated by null bytes, as defined by ID3v2.4.
+// Passing no values removes the frames.
+func (tag *Tag) SetUserDefinedText(description string, values ...string) {
+	tag.applyUserDefinedText(description, values)
+}
+
+// SetNormalizedDescriptions sets whether user-defined text frames are matched by normalized descriptions,
+// ignoring case and surrounding spaces, so "replaygain_track_gain" and "REPLAYGAIN_TRACK_GAIN"
+// are the same frame for AddUserDefinedTextFrame and GetUserDefinedTextFrame.
+// It's disabled by default, as the descriptions are case-sensitive according to the specification.
+func (tag *Tag) SetNormalizedDescriptions(enabled bool) {
+	tag.normalizedTXXX = enabled
+}
+
+// GetUserDefinedTextFrame returns the user-defined text frame (TXXX) with the description.
+// The description is matched exactly, or ignoring case and surrounding spaces
+// if SetNormalizedDescriptions is enabled.
+// The second value reports whether the frame was found.
+func (tag *Tag) GetUserDefinedTextFrame(description string) (UserDefinedTextFrame, bool) {
+	for _, f := range tag.GetFrames(tag.CommonID("User defined text information frame")) {
+		udtf, ok := f.(UserDefinedTextFrame)
+		if !ok {
+			continue
+		}
+
+		if udtf.Description == description ||
+			tag.normalizedTXXX && normalizeDescription(udtf.Description) == normalizeDescription(description) {
+			return udtf, true
+		}
+	}
+
+	return UserDefinedTextFrame{}, false
+}
+
+// DeduplicateUserDefinedTextFrames merges user-defined text frames whose descriptions differ
+// only in case or surrounding spaces (e.g., written by different taggers),
+// keeping the last added frame of each group. It returns the number of removed frames.
+func (tag *Tag) DeduplicateUserDefinedTextFrames() int {
+	if tag.rejectChange() {
+		return 0
+	}
+
+	id := tag.CommonID("User defined text information frame")
+	frames := tag.GetFrames(id)
+
+	last := make(map[string]int, len(frames))
+
+	for i, f := range frames {
+		if udtf, ok := f.(UserDefinedTextFrame); ok {
+			last[normalizeDescription(udtf.Description)] = i
+		}
+	}
+
+	var (
+		i       int
+		removed int
+	)
+
+	tag.deleteFramesFunc(id, func(f Framer) bool {
+		defer func() { i++ }()
+
+		udtf, ok := f.(UserDefinedTextFrame)
+		if !ok || last[normalizeDescription(udtf.Description)] == i {
+			return false
+		}
+
+		removed++
+
+		return true
+	})
+
+	return removed
+}
+
+// Deduplicate removes exact duplicates of frames that can appear several times in the tag,
+// commonly produced by repeated buggy tagging runs. Frames are duplicates if they have the same ID,
+// type and written content. Frames with the same unique identifier already replace each other
+// when they are added, so the duplicates are frames the tag can't tell apart by their identifiers:
+// unknown frames (e.g., private frames written twice) and frames kept apart by tag.SetSequenceKey.
+// The first frame of each group is kept. It returns the number of removed frames.
+func (tag *Tag) Deduplicate() int {
+	if tag.rejectChange() {
+		return 0
+	}
+
+	var removed int
+
+	for _, id := range slices.Sorted(maps.Keys(tag.sequences)) {
+		seen := make(map[string]bool)
+
+		tag.deleteFramesFunc(id, func(f Framer) bool {
+			key, ok := frameContentKey(f)
+			if !ok {
+				return false
+			}
+
+			if seen[key] {
+				removed++
+
+				return true
+			}
+
+			seen[key] = true
+
+			return false
+		})
+	}
+
+	return removed
+}
+
+// frameContentKey returns a key identifying the type and the written content of the frame.
+// It returns false for frames that can't be compared, e.g. streamed pictures.
+func frameContentKey(f Framer) (string, bool) {
+	if _, ok := f.(StreamedPictureFrame); ok {
+		return "", false
+	}
+
+	var buf bytes.Buffer
+	if _, err := f.WriteTo(&buf); err != nil {
+		return "", false
+	}
+
+	return fmt.Sprintf("%T\x00%s", f, buf.Bytes()), true
 }
 
 // AddUFIDFrame adds a unique file identifier frame (UFID) to the tag.
 // These frames store a unique identifier for the file.
+// The specification forbids several frames with the same owner,
+// so the frame replaces an existing frame with the same owner identifier.
 func (tag *Tag) AddUFIDFrame(ufid UFIDFrame) {
 	tag.AddFrame(tag.CommonID("Unique file identifier"), ufid)
 }
 
-// CommonID returns the frame ID corresponding to the given description.
-// For example, passing "Title" returns "TIT2".
+// SetUFID sets the unique file identifier of the owner (e.g., "http://musicbrainz.org"),
+// replacing the existing one.
+func (tag *Tag) SetUFID(owner string, identifier []byte) {
+	tag.AddUFIDFrame(UFIDFrame{OwnerIdentifier: owner, Identifier: identifier})
+}
+
+// GetUFIDFrame returns the unique file identifier frame (UFID) of the owner.
+// The second value reports whether the frame was found.
+func (tag *Tag) GetUFIDFrame(owner string) (UFIDFrame, bool) {
+	for _, f := range tag.GetFrames(tag.CommonID("Unique file identifier")) {
+		if ufid, ok := f.(UFIDFrame); ok && ufid.OwnerIdentifier == owner {
+			return ufid, true
+		}
+	}
+
+	return UFIDFrame{}, false
+}
+
+// CommonID returns the frame ID corresponding to the given description or frame ID.
+// For example, passing "Title" or FrameTIT2 returns "TIT2".
+// Frame IDs that were replaced between ID3v2.3 and ID3v2.4 are converted to the tag's version,
+// e.g. FrameTYER returns "TDRC" for an ID3v2.4 tag.
+// Custom descriptions can be added with SetCommonIDAlias and RegisterCommonIDAlias.
 // If the description isn't found, it returns the description itself.
-// All descriptions can be found in the common_ids.go.
+// All descriptions can be found in the common_ids.go, frame IDs in the frame_ids.go.
 func (tag *Tag) CommonID(description string) string {
-	var ids map[string]string
+	var (
+		ids         map[string]string
+		conversions map[FrameID]FrameID
+	)
+
 	if tag.version == 3 {
-		ids = V23CommonIDs
+		ids, conversions = V23CommonIDs, v23FrameIDConversions
 	} else {
-		ids = V24CommonIDs
+		ids, conversions = V24CommonIDs, v24FrameIDConversions
+	}
+
+	if id, ok := tag.commonIDAlias(description); ok {
+		if converted, ok := conversions[id]; ok { //nolint:govet // Shadowing is intended.
+			return converted
+		}
+
+		return id
 	}
 
 	if id, ok := ids[description]; ok {
 		return id
 	}
 
+	if id, ok := conversions[description]; ok {
+		return id
+	}
+
 	return description
 }
 
@@ -133,6 +444,23 @@ func (tag *Tag) AllFrames() map[string][]Framer {
 // DeleteAllFrames removes all frames from the tag.
 // This is useful for starting fresh when creating a new tag.
 func (tag *Tag) DeleteAllFrames() {
+	if tag.rejectChange() {
+		return
+	}
+
+	if tag.HasFrames() {
+		tag.modified = true
+	}
+
+	if len(tag.frameDeletedObservers) > 0 {
+		// The callback never fails.
+		_ = tag.iterateOverAllFramesInOrder(func(id string, f Framer) error {
+			tag.notifyFrameDeleted(id, f)
+
+			return nil
+		})
+	}
+
 	if tag.frames == nil || len(tag.frames) > 0 {
 		tag.frames = make(map[string]Framer)
 	}
@@ -146,8 +474,41 @@ func (tag *Tag) DeleteAllFrames() {
 	}
 }
 
+// DeleteTag removes the whole ID3v2 tag from the file the tag was initialized with.
+// All frames are deleted and the file is rewritten so that it contains only the music part,
+// without an empty tag header left behind.
+// Returns ErrNoFile if the tag wasn't initialized with a file.
+func (tag *Tag) DeleteTag() error {
+	return tag.DeleteTagWithOptions(SaveOptions{})
+}
+
+// DeleteTagWithOptions removes the ID3v2 tag from the file like DeleteTag,
+// using the provided options, e.g. to also strip ID3v1 and Lyrics3 trailers.
+func (tag *Tag) DeleteTagWithOptions(opts SaveOptions) error {
+	if _, err := tag.file(); err != nil {
+		return err
+	}
+
+	tag.DeleteAllFrames()
+
+	// There is nothing to strip if the file has no tag at all and the trailers are kept.
+	if tag.originalSize == 0 && !opts.StripTrailers && !opts.WriteID3v1 {
+		return nil
+	}
+
+	return tag.SaveWithOptions(opts)
+}
+
 // DeleteFrames removes all frames with the specified ID from the tag.
 func (tag *Tag) DeleteFrames(id string) {
+	if tag.rejectChange() {
+		return
+	}
+
+	for _, f := range tag.GetFrames(id) {
+		tag.track(id, f, nil)
+	}
+
 	delete(tag.frames, id)
 
 	if s, ok := tag.sequences[id]; ok {
@@ -159,6 +520,10 @@ func (tag *Tag) DeleteFrames(id string) {
 // Reset clears all frames in the tag and re-parses the provided reader with the given options.
 // This is useful for reusing a tag instance.
 func (tag *Tag) Reset(rd io.Reader, opts Options) error {
+	if tag.readOnly {
+		return ErrReadOnlyTag
+	}
+
 	tag.DeleteAllFrames()
 
 	return tag.parse(rd, opts)
@@ -216,6 +581,16 @@ func (tag *Tag) SetDefaultEncoding(encoding Encoding) {
 	tag.defaultEncoding = encoding
 }
 
+// WriteOptions returns the settings used when the tag is serialized.
+func (tag *Tag) WriteOptions() WriteOptions {
+	return tag.writeOptions
+}
+
+// SetWriteOptions sets the settings used when the tag is serialized by WriteTo and Save.
+func (tag *Tag) SetWriteOptions(opts WriteOptions) {
+	tag.writeOptions = opts
+}
+
 // setDefaultEncodingBasedOnVersion sets the default encoding based on the ID3v2 version.
 // ID3v2.4 uses UTF-8 by default, while earlier versions use ISO-8859-1.
 func (tag *Tag) setDefaultEncodingBasedOnVersion(version byte) {
@@ -312,25 +687,182 @@ func (tag *Tag) iterateOverAllFrames(f func(id string, frame Framer) error) erro
 	return nil
 }
 
-// Size returns the total size of the tag in bytes, including the tag header and all frames.
+// iterateOverAllFramesInOrder iterates over every frame in the tag like iterateOverAllFrames,
+// ordered by frame ID. Frames within a sequence keep the order in which they were added.
+func (tag *Tag) iterateOverAllFramesInOrder(f func(id string, frame Framer) error) error {
+	ids := make([]string, 0, len(tag.frames)+len(tag.sequences))
+	ids = slices.AppendSeq(ids, maps.Keys(tag.frames))
+	ids = slices.AppendSeq(ids, maps.Keys(tag.sequences))
+	slices.Sort(ids)
+
+	for _, id := range ids {
+		for _, frame := range tag.GetFrames(id) {
+			if err := f(id, frame); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Size returns the total size of the tag in bytes, including the tag header, all frames and padding,
+// as WriteTo writes it, i.e. after the write options and the registered serializers are applied.
+// Characters that can't be represented in the encoding of their frame are counted as substituted,
+// use SizeE to detect them. If a serializer fails, the size of the frames as they are is returned.
 func (tag *Tag) Size() int {
+	out, _, err := tag.output()
+	if err != nil {
+		return tag.size()
+	}
+
+	return out.size()
+}
+
+// size returns the total size of the tag with its frames written as they are, see Size.
+func (tag *Tag) size() int {
 	if !tag.HasFrames() {
 		return 0
 	}
 
+	// Unsynchronisation inserts bytes depending on the data, so it's applied to know the size.
+	if tag.headerFlags.Unsynchronisation {
+		if area, err := tag.unsynchronisedArea(true); err == nil {
+			return tagHeaderSize + len(area) + tag.footerSize()
+		}
+	}
+
+	n := tagHeaderSize + tag.framesSize() // The size of the tag without padding.
+
+	return n + tag.padding(n) + tag.footerSize()
+}
+
+// framesSize returns the size of all frames, including their headers.
+func (tag *Tag) framesSize() int {
 	var n int
-	n += tagHeaderSize // Add the size of the tag header.
 
-	err := tag.iterateOverAllFrames(func(_ string, f Framer) error {
+	// The callback never fails, so there is no error to handle.
+	_ = tag.iterateOverAllFrames(func(_ string, f Framer) error {
 		n += frameHeaderSize + f.Size() // Add the size of each frame.
 
 		return nil
 	})
+
+	return n
+}
+
+// SizeE returns the total size of the tag like Size, but fails with ErrUnencodableText
+// if any frame contains characters that can't be represented in its encoding,
+// because writing such a tag fails unless WriteOptions.SubstituteUnencodable is set.
+// It also fails with the error of a failed serializer.
+func (tag *Tag) SizeE() (int, error) {
+	out, _, err := tag.output()
 	if err != nil {
-		panic(err)
+		return 0, err
 	}
 
-	return n
+	return out.sizeE()
+}
+
+// sizeE returns the total size of the tag with its frames written as they are, see SizeE.
+func (tag *Tag) sizeE() (int, error) {
+	err := tag.iterateOverAllFrames(func(id string, f Framer) error {
+		encoding, ok := frameEncoding(f)
+		if !ok {
+			return nil
+		}
+
+		for _, text := range frameTexts(f) {
+			if err := checkEncodable(text, encoding); err != nil {
+				return fmt.Errorf("frame %s: %w", id, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.size(), nil
+}
+
+// padding returns the number of padding bytes written after the frames.
+func (tag *Tag) padding(unpadded int) int {
+	// Tags with footers must not have padding.
+	if tag.footerSize() > 0 {
+		return 0
+	}
+
+	padding := max(tag.writeOptions.Padding, 0)
+
+	if alignment := tag.writeOptions.AudioAlignment; alignment > 0 {
+		padding += (alignment - (unpadded+padding)%alignment) % alignment
+	}
+
+	return padding
+}
+
+// EstimateFileSize returns the size the file would have after Save, without writing anything.
+// It combines the serialized tag size (including padding) with the length of the music part
+// that follows the original tag. This is useful to validate quotas before saving.
+// The tag must be initialized with a reader whose size can be determined (e.g., a file).
+func (tag *Tag) EstimateFileSize() (int64, error) {
+	sourceSize, err := tag.sourceSize()
+	if err != nil {
+		return 0, err
+	}
+
+	size := tag.Size()
+
+	// DSF and DFF files keep everything around the tag, the tag itself is replaced.
+	if tag.container != ContainerMP3 {
+		return sourceSize - tag.originalSize + int64(size) + tag.containerOverhead(size), nil
+	}
+
+	// The music part is everything after the original tag.
+	musicSize := max(sourceSize-tag.offset-tag.originalSize, 0)
+
+	return int64(size) + musicSize, nil
+}
+
+// sourceSize returns the total size of the reader the tag was initialized with.
+// It supports readers that can be stat'ed (files) and readers that can seek.
+func (tag *Tag) sourceSize() (int64, error) {
+	switch rd := tag.reader.(type) {
+	case fs.File:
+		stat, err := rd.Stat()
+		if err != nil {
+			return 0, err
+		}
+
+		return stat.Size(), nil
+	case io.Seeker:
+		// Remember the current position to restore it afterwards.
+		current, err := rd.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return 0, err
+		}
+
+		end, err := rd.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0, err
+		}
+
+		if _, err = rd.Seek(current, io.SeekStart); err != nil {
+			return 0, err
+		}
+
+		return end, nil
+	default:
+		return 0, ErrNoFile
+	}
+}
+
+// Offset returns the offset of the tag in the file.
+// It is non-zero only if the tag was found after leading junk bytes with Options.ScanLimit.
+func (tag *Tag) Offset() int64 {
+	return tag.offset
 }
 
 // Version returns the ID3v2 version of the tag (e.g., 3 or 4).
@@ -341,21 +873,56 @@ func (tag *Tag) Version() byte {
 // SetVersion sets the ID3v2 version of the tag.
 // If the version is invalid (less than 3 or greater than 4), the function does nothing.
 func (tag *Tag) SetVersion(version byte) {
-	if version < 3 || version > 4 {
+	if version < 3 || version > 4 || tag.rejectChange() {
 		return
 	}
 
+	if version != tag.version {
+		tag.modified = true
+	}
+
 	tag.version = version
 	tag.setDefaultEncodingBasedOnVersion(version)
 }
 
 // Save writes the tag to the file if the tag was initialized with a file.
 // If there are no frames, it writes only the music part without any ID3v2 information.
-// Returns ErrNoFile if the tag wasn't initialized with a file.
+// Returns ErrNoFile if the tag wasn't initialized with a file
+// and ErrReadOnlyFS if the tag was opened with OpenFS.
 func (tag *Tag) Save() error {
-	file, ok := tag.reader.(*os.File)
-	if !ok {
-		return ErrNoFile
+	return tag.SaveWithOptions(SaveOptions{})
+}
+
+// SaveWithID3v1 writes the tag to the file like Save
+// and also writes or updates a trailing ID3v1.1 tag mirroring the basic fields.
+func (tag *Tag) SaveWithID3v1() error {
+	return tag.SaveWithOptions(SaveOptions{WriteID3v1: true})
+}
+
+// SaveWithOptions writes the tag to the file like Save, using the provided options.
+// For DSF and DFF files, the tag is written to the location defined by the container
+// and the ID3v1 and trailer options are ignored.
+func (tag *Tag) SaveWithOptions(opts SaveOptions) error {
+	file, err := tag.file()
+	if err != nil {
+		return err
+	}
+
+	// Refresh the length frame before the tag is written.
+	if opts.UpdateLength {
+		if err = tag.updateLength(); err != nil {
+			return err
+		}
+	}
+
+	// The file already holds the tag.
+	if opts.SkipUnmodified && !tag.Modified() {
+		return nil
+	}
+
+	// Stamp the tagging time after all other changes.
+	if opts.StampTaggingTime {
+		tag.stampTaggingTime()
 	}
 
 	// Get the original file's mode (permissions).
@@ -369,7 +936,7 @@ func (tag *Tag) Save() error {
 	// Create a temporary file to write the new tag.
 	name := file.Name() + "-id3v2"
 
-	newFile, err := os.OpenFile(filepath.Clean(name), os.O_RDWR|os.O_CREATE, originalStat.Mode())
+	newFile, err := os.OpenFile(filepath.Clean(name), os.O_RDWR|os.O_CREATE|os.O_TRUNC, originalStat.Mode())
 	if err != nil {
 		return err
 	}
@@ -382,22 +949,19 @@ func (tag *Tag) Save() error {
 		}
 	}()
 
-	// Write the tag to the temporary file.
-	tagSize, err := tag.WriteTo(newFile)
-	if err != nil {
-		return err
-	}
+	// Write the tag and the rest of the original file to the temporary file.
+	var tagSize, tagOffset int64
 
-	// Seek to the music part of the original file.
-	if _, err = originalFile.Seek(tag.originalSize, io.SeekStart); err != nil {
-		return err
+	switch tag.container {
+	case ContainerDSF:
+		tagSize, tagOffset, err = tag.writeDSFFile(newFile, originalFile, originalStat.Size())
+	case ContainerDFF:
+		tagSize, tagOffset, err = tag.writeDFFFile(newFile, originalFile, originalStat.Size())
+	default:
+		tagSize, err = tag.writeMP3File(newFile, originalFile, originalStat.Size(), opts)
 	}
 
-	// Copy the music part to the temporary file.
-	buf := getByteSlice(defaultSaveBufferSize)
-	defer putByteSlice(buf)
-
-	if _, err = io.CopyBuffer(newFile, originalFile, buf); err != nil {
+	if err != nil {
 		return err
 	}
 
@@ -418,8 +982,77 @@ func (tag *Tag) Save() error {
 		return err
 	}
 
-	// Update the tag's original size.
+	// Update the tag's original size and offset.
 	tag.originalSize = tagSize
+	tag.offset = tagOffset
+	tag.modified = false
+	tag.saves++
+
+	return nil
+}
+
+// writeMP3File writes the tag followed by the music part of the original MP3 file of size `size` to w.
+// It returns the number of bytes written for the tag.
+func (tag *Tag) writeMP3File(w io.Writer, originalFile *os.File, size int64, opts SaveOptions) (int64, error) {
+	var err error
+
+	// Determine where the music part of the original file ends.
+	musicEnd := size
+
+	switch {
+	case opts.StripTrailers:
+		// ID3v1 and Lyrics3 trailers are dropped, so they must not be copied as part of the music.
+		musicEnd, err = trailersStart(originalFile, musicEnd)
+		if err != nil {
+			return 0, err
+		}
+	case opts.WriteID3v1:
+		// An existing ID3v1 tag is replaced, so it must not be copied as part of the music.
+		hasID3v1, err := hasID3v1Tag(originalFile, musicEnd) //nolint:govet // Shadowing is intended.
+		if err != nil {
+			return 0, err
+		}
+
+		if hasID3v1 {
+			musicEnd -= id3v1TagSize
+		}
+	}
+
+	// Write the tag.
+	tagSize, err := tag.WriteTo(w)
+	if err != nil {
+		return 0, err
+	}
+
+	// Copy the music part. Junk bytes before the original tag are dropped.
+	musicStart := tag.offset + tag.originalSize
+	music := io.NewSectionReader(originalFile, musicStart, max(musicEnd-musicStart, 0))
+
+	buf := getByteSlice(defaultSaveBufferSize)
+	defer putByteSlice(buf)
+
+	if _, err = io.CopyBuffer(w, music, buf); err != nil {
+		return 0, err
+	}
+
+	// Append the ID3v1 tag after the music part.
+	if opts.WriteID3v1 {
+		if _, err = w.Write(tag.id3v1Tag().bytes()); err != nil {
+			return 0, err
+		}
+	}
+
+	return tagSize, nil
+}
+
+// updateLength sets the length frame (TLEN) to the duration of the audio stream in milliseconds.
+func (tag *Tag) updateLength() error {
+	info, err := tag.AudioInfo()
+	if err != nil {
+		return fmt.Errorf("error by reading audio info: %w", err)
+	}
+
+	tag.AddTextFrame(tag.CommonID("Length"), tag.DefaultEncoding(), strconv.FormatInt(info.Duration.Milliseconds(), 10))
 
 	return nil
 }
@@ -427,53 +1060,242 @@ func (tag *Tag) Save() error {
 // WriteTo writes the entire tag to the provided writer.
 // It returns the number of bytes written and any error encountered.
 // If there are no frames, it writes nothing.
+// Frames with serializers registered with RegisterFrameSerializer are transformed on the way.
+//
+// The output is deterministic: identical tags produce byte-identical output,
+// so build systems can hash it. Frames are written ordered by frame ID,
+// and frames with the same ID (e.g., pictures or comments) in the order they were added or parsed.
 func (tag *Tag) WriteTo(w io.Writer) (n int64, err error) {
+	return tag.WriteToFiltered(w, nil)
+}
+
+// WriteToFiltered writes the tag like WriteTo, but only the frames for which include returns true,
+// so a stripped-down tag (e.g., without pictures and private frames) can be emitted for streaming headers
+// without removing frames from the tag. The frames are passed to include as they are written,
+// i.e. after the registered serializers and the write options are applied. If include is nil, all frames are written.
+func (tag *Tag) WriteToFiltered(w io.Writer, include func(id string, f Framer) bool) (int64, error) {
 	if w == nil {
 		return 0, errors.New("w is nil")
 	}
 
-	// Calculate the size of the frames.
-	framesSize := tag.Size() - tagHeaderSize
-	if framesSize <= 0 {
+	out, truncations, err := tag.output()
+	if err != nil {
+		return 0, err
+	}
+
+	tag.truncations = truncations
+
+	if include != nil {
+		out = out.filtered(include)
+	}
+
+	return out.writeTo(w)
+}
+
+// output returns the tag as it's written, see serialized and prepared,
+// and the values truncated because of WriteOptions.MaxTextLengths.
+// The write options are applied after the serializers, so the frames they produce
+// (e.g., text decomposed by Unicode normalization) fall back to UTF-16 as well.
+// Texts are truncated last, so the written values are never longer than the limits.
+func (tag *Tag) output() (*Tag, []TextTruncation, error) {
+	serialized, err := tag.serialized()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := serialized.prepared()
+
+	if len(tag.writeOptions.MaxTextLengths) == 0 {
+		return out, nil, nil
+	}
+
+	// Truncating frames of the tag itself would change it.
+	if out == tag {
+		out = tag.filtered(func(string, Framer) bool { return true })
+	}
+
+	return out, out.TruncateTexts(tag.writeOptions.MaxTextLengths), nil
+}
+
+// prepared returns the tag with the write options that change frames applied:
+// fixed picture MIME types and the UTF-16 fallback of ID3v2.3.
+// They are applied to a copy, so writing never changes the frames of the tag.
+func (tag *Tag) prepared() *Tag {
+	var (
+		fixMimeTypes = tag.writeOptions.FixPictureMimeTypes
+		fallBack     = tag.Version() == 3 && !tag.writeOptions.StrictEncoding
+	)
+
+	if !fixMimeTypes && !fallBack {
+		return tag
+	}
+
+	out := tag.filtered(func(string, Framer) bool { return true })
+
+	if fixMimeTypes {
+		out.FixPictureMimeTypes()
+	}
+
+	if fallBack {
+		out.fallBackToUTF16()
+	}
+
+	return out
+}
+
+// filtered returns a copy of the tag with only the frames for which include returns true.
+func (tag *Tag) filtered(include func(id string, f Framer) bool) *Tag {
+	out := tag.writeCopy()
+
+	// The callback never fails.
+	_ = tag.iterateOverAllFramesInOrder(func(id string, f Framer) error {
+		if include(id, f) {
+			out.AddFrame(id, f)
+		}
+
+		return nil
+	})
+
+	return out
+}
+
+// WriteToDeterministic writes the tag like WriteTo and states the ordering contract explicitly
+// for build systems that hash their outputs: identical tags with identical write options
+// always produce byte-identical output, no matter how many times or in which process they are written.
+// Frames are ordered by frame ID, frames with the same ID keep the order in which they were added or parsed.
+// WriteTo follows the same contract.
+func (tag *Tag) WriteToDeterministic(w io.Writer) (int64, error) {
+	return tag.WriteTo(w)
+}
+
+// writeTo writes the tag to w as is, see WriteTo.
+func (tag *Tag) writeTo(w io.Writer) (n int64, err error) {
+	// Fail before anything is written if some text can't be encoded.
+	if !tag.writeOptions.SubstituteUnencodable {
+		if _, err = tag.sizeE(); err != nil {
+			return 0, err
+		}
+	}
+
+	if !tag.HasFrames() {
 		return 0, nil
 	}
 
+	// Calculate the size of the frames. With unsynchronisation it's known only after applying it.
+	var (
+		framesSize = tag.size() - tagHeaderSize - tag.footerSize()
+		area       []byte
+	)
+
+	if tag.headerFlags.Unsynchronisation {
+		if area, err = tag.unsynchronisedArea(tag.writeOptions.SubstituteUnencodable); err != nil {
+			return 0, err
+		}
+
+		framesSize = len(area)
+	}
+
 	// Write the tag header.
 	bw := getBufWriter(w)
 	defer putBufWriter(bw)
 
-	err = writeTagHeader(bw, uint(framesSize), tag.version)
+	bw.substitute = tag.writeOptions.SubstituteUnencodable
+
+	revision, flags := tag.headerRevisionAndFlags()
+
+	err = writeTagHeader(bw, uint(framesSize), tag.version, revision, flags)
 	if err != nil {
 		_ = bw.Flush()
 
 		return int64(bw.Written()), err
 	}
 
-	// Write all frames.
-	synchSafe := tag.Version() == 4
+	if area != nil {
+		_, err = bw.Write(area)
+	} else {
+		err = tag.writeFramesArea(bw)
+	}
 
-	err = tag.iterateOverAllFrames(func(id string, f Framer) error {
-		return writeFrame(bw, id, f, synchSafe)
-	})
 	if err != nil {
 		_ = bw.Flush()
 
 		return int64(bw.Written()), err
 	}
 
+	if tag.footerSize() > 0 {
+		if err = writeTagFooter(bw, uint(framesSize), tag.version, revision, flags); err != nil {
+			_ = bw.Flush()
+
+			return int64(bw.Written()), err
+		}
+	}
+
 	return int64(bw.Written()), bw.Flush()
 }
 
+// writeFramesArea writes all frames followed by the padding.
+func (tag *Tag) writeFramesArea(bw *bufferedWriter) error {
+	padding := tag.padding(tagHeaderSize + tag.framesSize())
+
+	synchSafe := tag.Version() == 4
+
+	err := tag.iterateOverAllFramesInOrder(func(id string, f Framer) error {
+		return writeFrame(bw, id, f, synchSafe)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Write the padding after the last frame.
+	writePadding(bw, padding)
+
+	return nil
+}
+
+// writePadding writes n zero bytes to the provided bufferedWriter.
+func writePadding(bw *bufferedWriter, n int) {
+	zeros := make([]byte, min(n, defaultBufferSize))
+
+	for n > 0 {
+		written, err := bw.Write(zeros[:min(n, len(zeros))])
+		if err != nil {
+			return // The error is kept by the bufferedWriter and returned on Flush.
+		}
+
+		n -= written
+	}
+}
+
+// Bytes serializes the entire tag into a byte slice.
+// It's a convenience wrapper around WriteTo. If there are no frames, it returns an empty slice.
+func (tag *Tag) Bytes() ([]byte, error) {
+	out, truncations, err := tag.output()
+	if err != nil {
+		return nil, err
+	}
+
+	tag.truncations = truncations
+
+	buf := new(bytes.Buffer)
+	buf.Grow(out.size())
+
+	if _, err = out.writeTo(buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
 // writeTagHeader writes the ID3v2 tag header to the provided bufferedWriter.
-func writeTagHeader(bw *bufferedWriter, framesSize uint, version byte) error {
+func writeTagHeader(bw *bufferedWriter, framesSize uint, version, revision, flags byte) error {
 	_, err := bw.Write(id3Identifier)
 	if err != nil {
 		return err
 	}
 
 	bw.WriteByte(version)
-	bw.WriteByte(0) // Revision
-	bw.WriteByte(0) // Flags
+	bw.WriteByte(revision)
+	bw.WriteByte(flags)
 	bw.WriteBytesSize(framesSize, true)
 
 	return nil
@@ -481,7 +1303,7 @@ func writeTagHeader(bw *bufferedWriter, framesSize uint, version byte) error {
 
 // writeFrame writes a single frame to the provided bufferedWriter.
 func writeFrame(bw *bufferedWriter, id string, frame Framer, synchSafe bool) error {
-	err := writeFrameHeader(bw, id, truncateIntToUint(frame.Size()), synchSafe)
+	err := writeFrameHeader(bw, id, truncateIntToUint(frame.Size()), synchSafe, 0)
 	if err != nil {
 		return err
 	}
@@ -491,20 +1313,39 @@ func writeFrame(bw *bufferedWriter, id string, frame Framer, synchSafe bool) err
 	return err
 }
 
-// writeFrameHeader writes the frame header to the provided bufferedWriter.
-func writeFrameHeader(bw *bufferedWriter, id string, frameSize uint, synchSafe bool) error {
+// writeFrameHeader writes the frame header with the format flags to the provided bufferedWriter.
+func writeFrameHeader(bw *bufferedWriter, id string, frameSize uint, synchSafe bool, formatFlags byte) error {
 	bw.WriteString(id)
 	bw.WriteBytesSize(frameSize, synchSafe)
 
-	_, err := bw.Write([]byte{0, 0}) // Flags
+	_, err := bw.Write([]byte{0, formatFlags}) // Status and format flags
 
 	return err
 }
 
-// Close closes the tag's file if it was initialized with a file.
+// file returns the writable file the tag was initialized with.
+// Returns ErrReadOnlyFS for tags opened with OpenFS and ErrNoFile if there is no file at all.
+func (tag *Tag) file() (*os.File, error) {
+	if tag.readOnly {
+		return nil, ErrReadOnlyTag
+	}
+
+	if tag.fsys != nil {
+		return nil, ErrReadOnlyFS
+	}
+
+	file, ok := tag.reader.(*os.File)
+	if !ok {
+		return nil, ErrNoFile
+	}
+
+	return file, nil
+}
+
+// Close closes the tag's file if it was initialized with a file, including files opened with OpenFS.
 // Returns ErrNoFile if the tag wasn't initialized with a file.
 func (tag *Tag) Close() error {
-	file, ok := tag.reader.(*os.File)
+	file, ok := tag.reader.(fs.File)
 	if !ok {
 		return ErrNoFile
 	}
@@ -3,18 +3,23 @@ package id3v2
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
+	"testing/fstest"
 )
 
 const (
-	mp3Path        = "testdata/test.mp3"
+	mp3Fixture     = "testdata/test.mp3"
 	multiMp3Path   = "testdata/test_multi.mp3"
 	frontCoverPath = "testdata/front_cover.jpg"
 	backCoverPath  = "testdata/back_cover.jpg"
@@ -26,6 +31,9 @@ const (
 )
 
 var (
+	// mp3Path is the copy of mp3Fixture the tests read and write, so the committed file stays intact.
+	mp3Path string
+
 	frontCover = PictureFrame{
 		Encoding:    EncodingUTF8,
 		MimeType:    "image/jpeg",
@@ -103,10 +111,27 @@ var (
 	parseOpts = Options{Parse: true}
 )
 
-func init() {
-	if err := resetMP3Tag(); err != nil {
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "id3v2")
+	if err != nil {
+		panic(fmt.Sprintf("Error while creating temp dir: %v", err))
+	}
+
+	mp3Path = filepath.Join(dir, filepath.Base(mp3Fixture))
+
+	if err = os.WriteFile(mp3Path, mustReadFile(mp3Fixture), 0o600); err != nil {
+		panic(fmt.Sprintf("Error while copying mp3 file: %v", err))
+	}
+
+	if err = resetMP3Tag(); err != nil {
 		panic(fmt.Sprintf("Error while reseting mp3 file: %v", err))
 	}
+
+	code := m.Run()
+
+	_ = os.RemoveAll(dir)
+
+	os.Exit(code)
 }
 
 // resetMP3Tag sets default tag in file located by mp3Path.
@@ -200,6 +225,46 @@ func TestCountLenSize(t *testing.T) {
 	}
 }
 
+// TestSizeMatchesWriteTo checks that tag.Size() counts the bytes WriteTo writes with the write options
+// and serializers that change frames. It isn't parallel, as the serializer applies to every written tag.
+func TestSizeMatchesWriteTo(t *testing.T) {
+	err := RegisterFrameSerializer("XSIZ", func(_ string, frame Framer) ([]Framer, error) {
+		if frame == nil {
+			return nil, nil
+		}
+
+		return []Framer{frame, frame}, nil // Write the frame twice.
+	})
+	if err != nil {
+		t.Fatalf("Error registering serializer: %v", err)
+	}
+
+	defer UnregisterFrameSerializer("XSIZ")
+
+	tag := NewEmptyTag()
+	tag.SetVersion(3)
+	tag.SetDefaultEncoding(EncodingISO)
+	tag.SetTitle("  Песня  ")
+	tag.SetArtist("Café")
+	tag.AddFrame("XSIZ", UnknownFrame{Body: []byte("serialized")})
+	tag.SetTextNormalizers(TrimSpace)
+	tag.SetWriteOptions(WriteOptions{UnicodeNormalization: UnicodeFormNFD, CompatibilityFrames: true})
+
+	size, err := tag.SizeE()
+	if err != nil {
+		t.Fatalf("Error computing size: %v", err)
+	}
+
+	n, err := tag.WriteTo(io.Discard)
+	if err != nil {
+		t.Fatalf("Error writing tag: %v", err)
+	}
+
+	if tag.Size() != int(n) || size != int(n) {
+		t.Errorf("Expected size %v, got Size %v and SizeE %v", n, tag.Size(), size)
+	}
+}
+
 // TestIntegrityOfMusicAtTheBeginning checks
 // if tag.Save() doesn't truncate or add some extra bytes at the beginning
 // of music part.
@@ -625,3 +690,574 @@ func TestWriteToN(t *testing.T) {
 		t.Errorf("buf.Len() and n are not equal: %v != %v ", buf.Len(), n)
 	}
 }
+
+// TestDeleteTag checks if tag.DeleteTag() strips the whole tag
+// and leaves only the music part in the file.
+func TestDeleteTag(t *testing.T) {
+	tmpFile, err := prepareTestFile("delete_tag_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	tmpFile.Close()
+
+	tag, err := Open(tmpFile.Name(), parseOpts)
+	if err != nil {
+		t.Fatal("Error while opening mp3 file:", err)
+	}
+
+	if err = tag.DeleteTag(); err != nil {
+		t.Fatal("Error while deleting tag:", err)
+	}
+
+	tag.Close()
+
+	stat, err := os.Stat(tmpFile.Name())
+	if err != nil {
+		t.Fatal("Error while getting mp3 file stat:", err)
+	}
+
+	if stat.Size() != musicSize {
+		t.Errorf("Expected file size: %v, got: %v", musicSize, stat.Size())
+	}
+
+	header := mustReadFile(tmpFile.Name())[:3]
+	if isID3Tag(header) {
+		t.Error("File should not start with an ID3 identifier after deleting the tag")
+	}
+}
+
+// TestRemoveTag checks if RemoveTag() strips the tag
+// and is a no-op for files without a tag.
+func TestRemoveTag(t *testing.T) {
+	tmpFile, err := prepareTestFile("remove_tag_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	tmpFile.Close()
+
+	// The second call must not fail on a file without a tag.
+	for range 2 {
+		if err = RemoveTag(tmpFile.Name()); err != nil {
+			t.Fatal("Error while removing tag:", err)
+		}
+	}
+
+	stat, err := os.Stat(tmpFile.Name())
+	if err != nil {
+		t.Fatal("Error while getting mp3 file stat:", err)
+	}
+
+	if stat.Size() != musicSize {
+		t.Errorf("Expected file size: %v, got: %v", musicSize, stat.Size())
+	}
+}
+
+// TestBytesAndParseBytes checks if a tag serialized with tag.Bytes()
+// is correctly parsed back with ParseBytes().
+func TestBytesAndParseBytes(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetTitle("Title")
+	tag.AddCommentFrame(engComm)
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatal("Error while serializing tag:", err)
+	}
+
+	if len(data) != tag.Size() {
+		t.Errorf("Expected %v bytes, got %v", tag.Size(), len(data))
+	}
+
+	parsedTag, err := ParseBytes(data, parseOpts)
+	if err != nil {
+		t.Fatal("Error while parsing bytes:", err)
+	}
+
+	if parsedTag.Title() != "Title" {
+		t.Errorf("Expected title: %q, got: %q", "Title", parsedTag.Title())
+	}
+
+	if err = compareCommentFrames(parsedTag.GetLastFrame(parsedTag.CommonID("Comments")).(CommentFrame), engComm); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestOpenFS checks if OpenFS parses tags from an fs.FS
+// and if tag.Save() refuses to write to a read-only file system.
+func TestOpenFS(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetTitle("Title")
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatal("Error while serializing tag:", err)
+	}
+
+	fsys := fstest.MapFS{"song.mp3": &fstest.MapFile{Data: data}}
+
+	tag, err = OpenFS(fsys, "song.mp3", parseOpts)
+	if err != nil {
+		t.Fatal("Error while opening mp3 file from fs:", err)
+	}
+
+	if tag.Title() != "Title" {
+		t.Errorf("Expected title: %q, got: %q", "Title", tag.Title())
+	}
+
+	if err = tag.Save(); !errors.Is(err, ErrReadOnlyFS) {
+		t.Errorf("Expected: %q, got: %q", ErrReadOnlyFS, err)
+	}
+
+	if err = tag.Close(); err != nil {
+		t.Error("Error while closing tag:", err)
+	}
+}
+
+// TestEstimateFileSize checks if tag.EstimateFileSize() matches
+// the real file size after tag.Save(), including padding.
+func TestEstimateFileSize(t *testing.T) {
+	tmpFile, err := prepareTestFile("estimate_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	tmpFile.Close()
+
+	tag, err := Open(tmpFile.Name(), parseOpts)
+	if err != nil {
+		t.Fatal("Error while opening mp3 file:", err)
+	}
+	defer tag.Close()
+
+	tag.SetTitle("A much longer title than before")
+	tag.SetWriteOptions(WriteOptions{Padding: 1024})
+
+	estimated, err := tag.EstimateFileSize()
+	if err != nil {
+		t.Fatal("Error while estimating file size:", err)
+	}
+
+	if err = tag.Save(); err != nil {
+		t.Fatal("Error while saving tag:", err)
+	}
+
+	stat, err := os.Stat(tmpFile.Name())
+	if err != nil {
+		t.Fatal("Error while getting mp3 file stat:", err)
+	}
+
+	if estimated != stat.Size() {
+		t.Errorf("Expected estimated size: %v, got: %v", stat.Size(), estimated)
+	}
+
+	// Padding must not be parsed as frames.
+	parsedTag, err := Open(tmpFile.Name(), parseOpts)
+	if err != nil {
+		t.Fatal("Error while opening mp3 file:", err)
+	}
+	defer parsedTag.Close()
+
+	if parsedTag.Count() != tag.Count() {
+		t.Errorf("Expected frames: %v, got: %v", tag.Count(), parsedTag.Count())
+	}
+}
+
+func TestCommonIDFrameIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		version  byte
+		id       FrameID
+		expected string
+	}{
+		{4, FrameTIT2, "TIT2"},
+		{4, FrameTYER, "TDRC"},
+		{4, FrameTORY, "TDOR"},
+		{3, FrameTDRC, "TYER"},
+		{3, FrameTYER, "TYER"},
+		{3, "Title", "TIT2"},
+	}
+
+	for _, tt := range tests {
+		tag := NewEmptyTag()
+		tag.SetVersion(tt.version)
+
+		if got := tag.CommonID(tt.id); got != tt.expected {
+			t.Errorf("Expected %v for %v in ID3v2.%d, got %v", tt.expected, tt.id, tt.version, got)
+		}
+	}
+}
+
+func TestNormalizedUserDefinedTextDescriptions(t *testing.T) {
+	t.Parallel()
+
+	txxx := func(description, value string) UserDefinedTextFrame {
+		return UserDefinedTextFrame{Encoding: EncodingUTF8, Description: description, Value: value}
+	}
+
+	tag := NewEmptyTag()
+	tag.AddUserDefinedTextFrame(txxx("replaygain_track_gain", "-1 dB"))
+	tag.AddUserDefinedTextFrame(txxx("Other", "x"))
+	tag.AddUserDefinedTextFrame(txxx("REPLAYGAIN_TRACK_GAIN", "-2 dB"))
+
+	if _, ok := tag.GetUserDefinedTextFrame("Replaygain_Track_Gain"); ok {
+		t.Error("Expected no frame to be found without normalized descriptions")
+	}
+
+	if removed := tag.DeduplicateUserDefinedTextFrames(); removed != 1 {
+		t.Errorf("Expected 1 removed frame, got %d", removed)
+	}
+
+	tag.SetNormalizedDescriptions(true)
+
+	udtf, ok := tag.GetUserDefinedTextFrame("Replaygain_Track_Gain")
+	if !ok || udtf.Value != "-2 dB" {
+		t.Errorf("Expected value %q, got %q (found: %v)", "-2 dB", udtf.Value, ok)
+	}
+
+	tag.AddUserDefinedTextFrame(txxx(" replaygain_track_gain", "-3 dB"))
+
+	if count := len(tag.GetFrames(tag.CommonID("User defined text information frame"))); count != 2 {
+		t.Errorf("Expected 2 frames, got %d", count)
+	}
+
+	if udtf, _ = tag.GetUserDefinedTextFrame("REPLAYGAIN_TRACK_GAIN"); udtf.Value != "-3 dB" {
+		t.Errorf("Expected value %q, got %q", "-3 dB", udtf.Value)
+	}
+}
+
+func TestUserDefinedTextMultipleValues(t *testing.T) {
+	t.Parallel()
+
+	for _, encoding := range []Encoding{EncodingISO, EncodingUTF16, EncodingUTF16BE, EncodingUTF8} {
+		tag := NewEmptyTag()
+		tag.SetDefaultEncoding(encoding)
+		tag.SetUserDefinedText("ARTISTS", "First", "Second", "Third")
+
+		var buf bytes.Buffer
+		if _, err := tag.WriteTo(&buf); err != nil {
+			t.Fatal(err)
+		}
+
+		if buf.Len() != tag.Size() {
+			t.Errorf("%s: expected size %d, got %d", encoding, tag.Size(), buf.Len())
+		}
+
+		parsed, err := ParseBytes(buf.Bytes(), parseOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		udtf, _ := parsed.GetUserDefinedTextFrame("ARTISTS")
+		if expected := []string{"First", "Second", "Third"}; !slices.Equal(udtf.Multi, expected) {
+			t.Errorf("%s: expected %q, got %q", encoding, expected, udtf.Multi)
+		}
+
+		// Value replaces the first value.
+		udtf.Value = "New"
+		if expected := []string{"New", "Second", "Third"}; !slices.Equal(udtf.Values(), expected) {
+			t.Errorf("%s: expected %q, got %q", encoding, expected, udtf.Values())
+		}
+	}
+}
+
+func TestTextFrameMultipleValues(t *testing.T) {
+	t.Parallel()
+
+	values := []string{"Rock", "Pop", "Jazz"}
+
+	for _, encoding := range []Encoding{EncodingISO, EncodingUTF16, EncodingUTF16BE, EncodingUTF8} {
+		tag := NewEmptyTag()
+		tag.AddFrame("TCON", TextFrame{Encoding: encoding, Text: values[0], Multi: values})
+
+		var buf bytes.Buffer
+		if _, err := tag.WriteTo(&buf); err != nil {
+			t.Fatal(err)
+		}
+
+		if buf.Len() != tag.Size() {
+			t.Errorf("%s: expected size %d, got %d", encoding, tag.Size(), buf.Len())
+		}
+
+		parsed, err := ParseBytes(buf.Bytes(), parseOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if tf := parsed.GetTextFrame("TCON"); !slices.Equal(tf.Multi, values) {
+			t.Errorf("%s: expected %q, got %q", encoding, values, tf.Multi)
+		}
+	}
+}
+
+func TestUFIDByOwner(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.AddUFIDFrame(UFIDFrame{OwnerIdentifier: "http://musicbrainz.org", Identifier: []byte("old")})
+	tag.SetUFID("http://example.com", []byte("other"))
+	tag.SetUFID("http://musicbrainz.org", []byte("new"))
+
+	if count := len(tag.GetFrames(tag.CommonID("Unique file identifier"))); count != 2 {
+		t.Errorf("Expected 2 UFID frames, got %d", count)
+	}
+
+	ufid, ok := tag.GetUFIDFrame("http://musicbrainz.org")
+	if !ok || string(ufid.Identifier) != "new" {
+		t.Errorf("Expected identifier %q, got %q (found: %v)", "new", ufid.Identifier, ok)
+	}
+
+	if _, ok = tag.GetUFIDFrame("http://missing.org"); ok {
+		t.Error("Expected no frame for an unknown owner")
+	}
+}
+
+func TestWriteToDeterministic(t *testing.T) {
+	t.Parallel()
+
+	build := func() *Tag {
+		tag := NewEmptyTag()
+		tag.SetTitle("Title")
+		tag.SetArtist("Artist")
+		tag.SetAlbum("Album")
+		tag.SetYear("2024")
+		tag.AddFrame("PRIV", UnknownFrame{Body: []byte("first")})
+		tag.AddFrame("PRIV", UnknownFrame{Body: []byte("second")})
+		tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: "b", Text: "B"})
+		tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: "a", Text: "A"})
+
+		return tag
+	}
+
+	var expected bytes.Buffer
+	if _, err := build().WriteToDeterministic(&expected); err != nil {
+		t.Fatalf("Error while writing tag: %v", err)
+	}
+
+	for range 10 {
+		var actual bytes.Buffer
+		if _, err := build().WriteToDeterministic(&actual); err != nil {
+			t.Fatalf("Error while writing tag: %v", err)
+		}
+
+		if !bytes.Equal(expected.Bytes(), actual.Bytes()) {
+			t.Fatalf("Expected byte-identical output, got different bytes")
+		}
+	}
+
+	parsed, err := ParseBytes(expected.Bytes(), Options{Parse: true})
+	if err != nil {
+		t.Fatalf("Error while parsing tag: %v", err)
+	}
+
+	comments := parsed.GetFrames(parsed.CommonID("Comments"))
+	if len(comments) != 2 || comments[0].(CommentFrame).Description != "b" {
+		t.Errorf("Expected comments in insertion order, got %v", comments)
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetTitle("Title")
+	tag.AddFrame("PRIV", UnknownFrame{Body: []byte("owner\x00data")})
+	tag.AddFrame("PRIV", UnknownFrame{Body: []byte("owner\x00other")})
+	tag.AddFrame("PRIV", UnknownFrame{Body: []byte("owner\x00data")})
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: "a", Text: "A"})
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: "b", Text: "A"})
+
+	if removed := tag.Deduplicate(); removed != 1 {
+		t.Errorf("Expected 1 removed frame, got %d", removed)
+	}
+
+	privs := tag.GetFrames("PRIV")
+	if len(privs) != 2 || string(privs[1].(UnknownFrame).Body) != "owner\x00other" {
+		t.Errorf("Expected 2 distinct PRIV frames, got %v", privs)
+	}
+
+	if count := len(tag.GetFrames(tag.CommonID("Comments"))); count != 2 {
+		t.Errorf("Expected 2 comments, got %d", count)
+	}
+
+	if removed := tag.Deduplicate(); removed != 0 {
+		t.Errorf("Expected 0 removed frames, got %d", removed)
+	}
+
+	// Frames kept apart by a sequence key are compared by content as well.
+	var added int
+
+	tag.SetSequenceKey("Comments", func(Framer) string {
+		added++
+
+		return strconv.Itoa(added)
+	})
+
+	comment := CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: "a", Text: "A"}
+	tag.AddCommentFrame(comment)
+	tag.AddCommentFrame(comment)
+
+	if removed := tag.Deduplicate(); removed != 2 {
+		t.Errorf("Expected 2 removed frames, got %d", removed)
+	}
+
+	if count := len(tag.GetFrames(tag.CommonID("Comments"))); count != 2 {
+		t.Errorf("Expected 2 comments, got %d", count)
+	}
+}
+
+func TestWriteToFiltered(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetTitle("Title")
+	tag.AddFrame(string(FramePRIV), UnknownFrame{Body: []byte("private")})
+	tag.AddAttachedPicture(PictureFrame{
+		Encoding:    EncodingUTF8,
+		MimeType:    MimeTypeJPEG,
+		PictureType: PTFrontCover,
+		Picture:     []byte{1, 2, 3},
+	})
+
+	var buf bytes.Buffer
+
+	_, err := tag.WriteToFiltered(&buf, func(id string, _ Framer) bool {
+		return id != "APIC" && id != "PRIV"
+	})
+	if err != nil {
+		t.Fatal("Error while writing tag:", err)
+	}
+
+	parsed, err := ParseReader(&buf, parseOpts)
+	if err != nil {
+		t.Fatal("Error while parsing tag:", err)
+	}
+
+	if frames := parsed.AllFrames(); len(frames) != 1 || parsed.Title() != "Title" {
+		t.Errorf("Expected only the title, got %v", frames)
+	}
+
+	if count := len(tag.AllFrames()); count != 3 {
+		t.Errorf("Expected the tag to keep 3 frames, got %d", count)
+	}
+}
+
+// TestWriteToKeepsTag checks that the write options changing frames are applied to the written tag only.
+func TestWriteToKeepsTag(t *testing.T) {
+	t.Parallel()
+
+	source := NewEmptyTag()
+	source.SetVersion(3)
+	source.SetTitle("Long title")
+	source.AddAttachedPicture(PictureFrame{
+		Encoding:    EncodingISO,
+		MimeType:    "image/jpg",
+		PictureType: PTFrontCover,
+		Picture:     []byte{0xFF, 0xD8, 0xFF},
+	})
+
+	data, err := source.Bytes()
+	if err != nil {
+		t.Fatal("Error while writing tag:", err)
+	}
+
+	tag, err := ParseBytes(data, parseOpts)
+	if err != nil {
+		t.Fatal("Error while parsing tag:", err)
+	}
+
+	tag.AddFrame(tag.CommonID("Artist"), TextFrame{Encoding: EncodingISO, Text: "Исполнитель"})
+	tag.SetWriteOptions(WriteOptions{FixPictureMimeTypes: true, MaxTextLengths: map[string]int{"Title": 4}})
+
+	var changes int
+
+	tag.OnFrameAdded(func(string, Framer) { changes++ })
+	tag.OnFrameDeleted(func(string, Framer) { changes++ })
+
+	frames := tag.AllFrames()
+
+	if data, err = tag.Bytes(); err != nil {
+		t.Fatal("Error while writing tag:", err)
+	}
+
+	if got := tag.AllFrames(); !reflect.DeepEqual(got, frames) {
+		t.Errorf("Expected frames %v, got %v", frames, got)
+	}
+
+	if changes != 0 {
+		t.Errorf("Expected no reported changes, got %d", changes)
+	}
+
+	if picture, _ := tag.GetLastFrame(tag.CommonID("Attached picture")).(PictureFrame); picture.MimeType != "image/jpg" {
+		t.Errorf("Expected the MIME type of the picture to be kept, got %v", picture)
+	}
+
+	if truncations := tag.Truncations(); len(truncations) == 0 || truncations[0].Original != "Long title" {
+		t.Errorf("Expected the title to be reported as truncated, got %v", truncations)
+	}
+
+	written, err := ParseBytes(data, parseOpts)
+	if err != nil {
+		t.Fatal("Error while parsing tag:", err)
+	}
+
+	if written.Title() != "Long" || written.Artist() != "Исполнитель" {
+		t.Errorf("Expected the written tag to be changed, got %v", written.AllFrames())
+	}
+
+	if picture, _ := written.GetLastFrame(written.CommonID("Attached picture")).(PictureFrame); picture.MimeType != MimeTypeJPEG {
+		t.Errorf("Expected a picture with the fixed MIME type, got %v", picture)
+	}
+
+	// A tag without added frames stays unmodified.
+	tag, err = ParseBytes(data, parseOpts)
+	if err != nil {
+		t.Fatal("Error while parsing tag:", err)
+	}
+
+	tag.SetWriteOptions(WriteOptions{MaxTextLengths: map[string]int{"Title": 2}})
+
+	if _, err = tag.Bytes(); err != nil {
+		t.Fatal("Error while writing tag:", err)
+	}
+
+	if tag.Modified() || tag.Title() != "Long" {
+		t.Errorf("Expected an unmodified tag, got title %q, modified %v", tag.Title(), tag.Modified())
+	}
+}
+
+func TestAudioAlignment(t *testing.T) {
+	t.Parallel()
+
+	for _, flags := range []HeaderFlags{{}, {Unsynchronisation: true}} {
+		tag := NewEmptyTag()
+		tag.SetTitle("Title")
+		tag.SetHeaderFlags(flags)
+		tag.SetWriteOptions(WriteOptions{Padding: 100, AudioAlignment: 4096})
+
+		data, err := tag.Bytes()
+		if err != nil {
+			t.Fatal("Error while writing tag:", err)
+		}
+
+		if len(data) != 4096 || tag.Size() != 4096 {
+			t.Errorf("%+v: expected size 4096, got %d and %d", flags, len(data), tag.Size())
+		}
+
+		// The padding isn't reduced to reach the boundary.
+		tag.SetWriteOptions(WriteOptions{Padding: 4096, AudioAlignment: 4096})
+
+		if size := tag.Size(); size != 8192 {
+			t.Errorf("%+v: expected size 8192, got %d", flags, size)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package id3v2
+
+import "time"
+
+// stampTaggingTime sets the tagging time (TDTG) to the current UTC time if the tag was modified,
+// see tag.Modified. TDTG is defined only in ID3v2.4, so ID3v2.3 tags aren't stamped.
+func (tag *Tag) stampTaggingTime() {
+	if tag.Version() != 4 || !tag.Modified() {
+		return
+	}
+
+	tag.SetTaggingTime(Timestamp{Time: time.Now().UTC().Truncate(time.Second), Precision: PrecisionSecond})
+}
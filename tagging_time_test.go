@@ -0,0 +1,81 @@
+package id3v2
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSaveWithStampTaggingTime(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "test.mp3")
+	copyTestFile(t, name)
+
+	stamped := func() Timestamp {
+		t.Helper()
+
+		tag, err := Open(name, Options{Parse: true})
+		if err != nil {
+			t.Fatalf("Error while opening file: %v", err)
+		}
+		defer tag.Close()
+
+		ts, err := tag.TaggingTime()
+		if err != nil {
+			t.Fatalf("Error while reading tagging time: %v", err)
+		}
+
+		return ts
+	}
+
+	save := func(modify func(*Tag)) {
+		t.Helper()
+
+		tag, err := Open(name, Options{Parse: true})
+		if err != nil {
+			t.Fatalf("Error while opening file: %v", err)
+		}
+		defer tag.Close()
+
+		modify(tag)
+
+		if err = tag.SaveWithOptions(SaveOptions{StampTaggingTime: true}); err != nil {
+			t.Fatalf("Error while saving tag: %v", err)
+		}
+	}
+
+	save(func(*Tag) {})
+
+	if ts := stamped(); !ts.IsZero() {
+		t.Errorf("Expected no tagging time for an unmodified tag, got %q", ts)
+	}
+
+	before := time.Now().UTC().Truncate(time.Second)
+
+	save(func(tag *Tag) { tag.SetTitle("Stamped") })
+
+	ts := stamped()
+	if ts.Precision != PrecisionSecond || ts.Time.Before(before) {
+		t.Errorf("Expected tagging time not before %s, got %q", before, ts)
+	}
+
+	// Saving the unchanged tag again must keep the previous tagging time.
+	save(func(*Tag) {})
+
+	if again := stamped(); !again.Time.Equal(ts.Time) {
+		t.Errorf("Expected tagging time %q, got %q", ts, again)
+	}
+
+	// TDTG isn't defined in ID3v2.3.
+	save(func(tag *Tag) {
+		tag.SetVersion(3)
+		tag.SetTaggingTime(Timestamp{})
+	})
+
+	save(func(tag *Tag) { tag.SetTitle("Stamped again") })
+
+	if ts = stamped(); !ts.IsZero() {
+		t.Errorf("Expected no tagging time in an ID3v2.3 tag, got %q", ts)
+	}
+}
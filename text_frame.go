@@ -7,8 +7,8 @@ import "io"
 // It stores text data along with its encoding and supports multiple values for certain frames.
 type TextFrame struct {
 	Encoding Encoding // The encoding used for the text (e.g., UTF-8, ISO-8859-1).
-	Text     string   // The primary text value of the frame.
-	Multi    []string // Additional text values, used for frames that support multiple entries.
+	Text     string   // The primary text value of the frame, the first one of multiple values.
+	Multi    []string // All values of a multi-value frame, starting with Text, empty for a single value.
 }
 
 // textFrameUniqueIdentifier is a constant used to uniquely identify text frames.
@@ -16,9 +16,20 @@ type TextFrame struct {
 const textFrameUniqueIdentifier = "ID"
 
 // Size calculates the total size of the TextFrame in bytes.
-// This includes the encoding byte, the encoded text, and the termination bytes.
+// This includes the encoding byte, the encoded values, and the termination bytes.
 func (tf TextFrame) Size() int {
-	return 1 + encodedSize(tf.Text, tf.Encoding) + len(tf.Encoding.TerminationBytes)
+	return 1 + encodedValuesSize(tf.Values(), tf.Encoding) + len(tf.Encoding.TerminationBytes)
+}
+
+// Values returns all values of the frame: Text followed by the rest of Multi.
+// Text takes precedence over the first element of Multi, so changing Text alone is enough
+// for single-value frames.
+func (tf TextFrame) Values() []string {
+	if len(tf.Multi) <= 1 {
+		return []string{tf.Text}
+	}
+
+	return append([]string{tf.Text}, tf.Multi[1:]...)
 }
 
 // UniqueIdentifier returns a unique identifier for the TextFrame.
@@ -28,15 +39,17 @@ func (tf TextFrame) UniqueIdentifier() string {
 }
 
 // WriteTo writes the TextFrame to the provided io.Writer.
-// It encodes the text using the specified encoding and writes the frame's data.
+// It encodes the values using the specified encoding and writes the frame's data.
+// Multiple values are separated by termination bytes, as defined by ID3v2.4
+// and by the involved people list (IPLS) of ID3v2.3.
 // Returns the number of bytes written and any error encountered.
 func (tf TextFrame) WriteTo(w io.Writer) (int64, error) {
 	return useBufferedWriter(w, func(bw *bufferedWriter) error {
 		// Write the encoding byte.
 		bw.WriteByte(tf.Encoding.Key)
 
-		// Encode and write the text using the specified encoding.
-		bw.EncodeAndWriteText(tf.Text, tf.Encoding)
+		// Encode and write the values using the specified encoding.
+		bw.EncodeAndWriteValues(tf.Values(), tf.Encoding)
 
 		// Write the termination bytes for the encoding.
 		_, err := bw.Write(tf.Encoding.TerminationBytes)
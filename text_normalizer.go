@@ -0,0 +1,110 @@
+package id3v2
+
+import (
+	"strings"
+	"unicode"
+)
+
+// TextNormalizer transforms a text value of a frame before it's written, see tag.SetTextNormalizers.
+type TextNormalizer func(text string) string
+
+// TrimSpace is a TextNormalizer removing leading and trailing whitespace.
+func TrimSpace(text string) string {
+	return strings.TrimSpace(text)
+}
+
+// CollapseSpaces is a TextNormalizer replacing runs of whitespace inside the text with a single space.
+// Leading and trailing whitespace is kept, use TrimSpace to remove it.
+func CollapseSpaces(text string) string {
+	var (
+		sb      strings.Builder
+		inSpace bool
+	)
+
+	sb.Grow(len(text))
+
+	for _, r := range text {
+		if unicode.IsSpace(r) {
+			if !inSpace {
+				sb.WriteByte(' ')
+			}
+
+			inSpace = true
+
+			continue
+		}
+
+		inSpace = false
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// StripControlCharacters is a TextNormalizer removing control characters (e.g., NUL or escape),
+// except tabs and line breaks, which are valid in lyrics and comments.
+func StripControlCharacters(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) && r != '\t' && r != '\n' && r != '\r' {
+			return -1
+		}
+
+		return r
+	}, text)
+}
+
+// SetTextNormalizers sets the chain of normalizers applied by WriteTo and Save
+// to the values of all text frames (including user-defined ones), in the given order.
+// Values that end up empty are dropped, and so are frames without values,
+// e.g. a title consisting of spaces isn't written with TrimSpace.
+// The frames stored in the tag aren't changed, only the written tag is.
+// Calling it without normalizers disables normalization.
+func (tag *Tag) SetTextNormalizers(normalizers ...TextNormalizer) {
+	tag.textNormalizers = normalizers
+}
+
+// normalizeTextFrame applies the text normalizers to the frame if it's a text frame.
+// It returns false if the frame has no values left and should be dropped.
+func (tag *Tag) normalizeTextFrame(f Framer) (Framer, bool) {
+	switch frame := f.(type) {
+	case TextFrame:
+		frame.Text = tag.normalizeText(frame.Text)
+		frame.Multi = tag.normalizeValues(frame.Multi)
+
+		return frame, frame.Text != "" || len(frame.Multi) > 0
+	case UserDefinedTextFrame:
+		frame.Value = tag.normalizeText(frame.Value)
+		frame.Multi = tag.normalizeValues(frame.Multi)
+
+		return frame, frame.Value != "" || len(frame.Multi) > 0
+	default:
+		return f, true
+	}
+}
+
+// normalizeText passes the text through the chain of text normalizers.
+func (tag *Tag) normalizeText(text string) string {
+	for _, normalize := range tag.textNormalizers {
+		text = normalize(text)
+	}
+
+	return text
+}
+
+// normalizeValues normalizes every value and drops the ones that end up empty.
+func (tag *Tag) normalizeValues(values []string) []string {
+	if len(values) == 0 {
+		return values
+	}
+
+	normalized := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if value = tag.normalizeText(value); value != "" {
+			normalized = append(normalized, value)
+		}
+	}
+
+	return normalized
+}
@@ -0,0 +1,48 @@
+package id3v2
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTextNormalizers(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetDefaultEncoding(EncodingUTF8)
+	tag.SetTitle("  The \x00Title   of\tit ")
+	tag.SetArtist("   ")
+	tag.AddUserDefinedTextFrame(UserDefinedTextFrame{Encoding: EncodingUTF8, Description: "empty", Value: " "})
+	tag.AddUserDefinedTextFrame(UserDefinedTextFrame{Encoding: EncodingUTF8, Description: "kept", Value: " value "})
+	tag.SetTextNormalizers(StripControlCharacters, CollapseSpaces, TrimSpace)
+
+	var buf bytes.Buffer
+	if _, err := tag.WriteTo(&buf); err != nil {
+		t.Fatalf("Error while writing tag: %v", err)
+	}
+
+	if tag.Title() != "  The \x00Title   of\tit " {
+		t.Errorf("Expected the frames stored in the tag to be unchanged, got %q", tag.Title())
+	}
+
+	parsed, err := ParseBytes(buf.Bytes(), Options{Parse: true})
+	if err != nil {
+		t.Fatalf("Error while parsing tag: %v", err)
+	}
+
+	if parsed.Title() != "The Title of it" {
+		t.Errorf("Expected title %q, got %q", "The Title of it", parsed.Title())
+	}
+
+	if frames := parsed.GetFrames(parsed.CommonID("Artist")); len(frames) != 0 {
+		t.Errorf("Expected empty artist frame to be dropped, got %v", frames)
+	}
+
+	if _, ok := parsed.GetUserDefinedTextFrame("empty"); ok {
+		t.Error("Expected empty user-defined text frame to be dropped")
+	}
+
+	if udtf, ok := parsed.GetUserDefinedTextFrame("kept"); !ok || udtf.Value != "value" {
+		t.Errorf("Expected value %q, got %q (found: %v)", "value", udtf.Value, ok)
+	}
+}
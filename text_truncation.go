@@ -0,0 +1,131 @@
+package id3v2
+
+import (
+	"cmp"
+	"slices"
+	"unicode/utf8"
+)
+
+// TextTruncation describes a text value shortened by tag.TruncateTexts.
+type TextTruncation struct {
+	FrameID   string // The ID of the frame the value belongs to.
+	Original  string // The value before truncation.
+	Truncated string // The value after truncation.
+}
+
+// TruncateTexts shortens the values of text, user-defined text and comment frames
+// to the maximum lengths in runes given by limits, e.g. for players that show only 30 or 64 characters.
+// The keys of limits are frame IDs or descriptions (e.g., "TIT2" or "Title").
+// Values are cut at rune boundaries, so no character is mangled.
+// It returns the truncated values ordered by frame ID. Non-positive limits are ignored.
+func (tag *Tag) TruncateTexts(limits map[string]int) []TextTruncation {
+	if tag.rejectChange() {
+		return nil
+	}
+
+	var truncations []TextTruncation
+
+	for key, limit := range limits {
+		if limit <= 0 {
+			continue
+		}
+
+		id := tag.CommonID(key)
+		truncate := func(f Framer) Framer {
+			frame, report := truncateFrameTexts(f, limit)
+			for i := range report {
+				report[i].FrameID = id
+			}
+
+			truncations = append(truncations, report...)
+
+			return frame
+		}
+
+		if f, ok := tag.frames[id]; ok {
+			tag.frames[id] = tag.track(id, f, truncate(f))
+		} else if s, ok := tag.sequences[id]; ok { //nolint:govet // Shadowing is intended.
+			for i, frame := range s.frames {
+				s.frames[i] = tag.track(id, frame, truncate(frame))
+			}
+		}
+	}
+
+	slices.SortStableFunc(truncations, func(a, b TextTruncation) int {
+		return cmp.Compare(a.FrameID, b.FrameID)
+	})
+
+	return truncations
+}
+
+// Truncations returns the values truncated by the last write
+// with WriteOptions.MaxTextLengths set, see tag.TruncateTexts.
+func (tag *Tag) Truncations() []TextTruncation {
+	return tag.truncations
+}
+
+// truncateFrameTexts returns a copy of the frame with its values truncated to limit runes
+// and the list of truncated values without frame IDs.
+func truncateFrameTexts(frame Framer, limit int) (Framer, []TextTruncation) {
+	var report []TextTruncation
+
+	truncate := func(s string) string {
+		truncated, ok := truncateRunes(s, limit)
+		if ok {
+			report = append(report, TextTruncation{Original: s, Truncated: truncated})
+		}
+
+		return truncated
+	}
+
+	truncateAll := func(values []string) []string {
+		if values == nil {
+			return nil
+		}
+
+		truncated := slices.Clone(values)
+		for i := range truncated {
+			truncated[i] = truncate(truncated[i])
+		}
+
+		return truncated
+	}
+
+	switch f := frame.(type) {
+	case TextFrame:
+		f.Text = truncate(f.Text)
+		f.Multi = truncateAll(f.Multi)
+
+		return f, report
+	case UserDefinedTextFrame:
+		f.Value = truncate(f.Value)
+		f.Multi = truncateAll(f.Multi)
+
+		return f, report
+	case CommentFrame:
+		f.Text = truncate(f.Text)
+
+		return f, report
+	default:
+		return frame, nil
+	}
+}
+
+// truncateRunes cuts s to at most limit runes. It reports whether s was shortened.
+func truncateRunes(s string, limit int) (string, bool) {
+	if utf8.RuneCountInString(s) <= limit {
+		return s, false
+	}
+
+	var runes int
+
+	for i := range s {
+		if runes == limit {
+			return s[:i], true
+		}
+
+		runes++
+	}
+
+	return s, false
+}
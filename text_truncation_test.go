@@ -0,0 +1,95 @@
+package id3v2
+
+import (
+	"bytes"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncateTexts(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetDefaultEncoding(EncodingUTF8)
+	tag.SetTitle("Привет, мир")
+	tag.SetArtist("Short")
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Text: "A rather long comment"})
+
+	truncations := tag.TruncateTexts(map[string]int{"Title": 6, "TPE1": 30, "COMM": 8, "TALB": 0})
+
+	expected := []TextTruncation{
+		{FrameID: "COMM", Original: "A rather long comment", Truncated: "A rather"},
+		{FrameID: "TIT2", Original: "Привет, мир", Truncated: "Привет"},
+	}
+
+	if len(truncations) != len(expected) {
+		t.Fatalf("Expected %d truncations, got %v", len(expected), truncations)
+	}
+
+	for i := range expected {
+		if truncations[i] != expected[i] {
+			t.Errorf("Expected %+v, got %+v", expected[i], truncations[i])
+		}
+	}
+
+	if tag.Title() != "Привет" || tag.Artist() != "Short" {
+		t.Errorf("Expected title %q and artist %q, got %q and %q", "Привет", "Short", tag.Title(), tag.Artist())
+	}
+}
+
+func TestWriteOptionsMaxTextLengths(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetDefaultEncoding(EncodingUTF8)
+	tag.SetAlbum("An album title longer than thirty characters")
+	tag.SetWriteOptions(WriteOptions{MaxTextLengths: map[string]int{"TALB": 30}})
+
+	var buf bytes.Buffer
+	if _, err := tag.WriteTo(&buf); err != nil {
+		t.Fatalf("Error while writing tag: %v", err)
+	}
+
+	parsed, err := ParseBytes(buf.Bytes(), Options{Parse: true})
+	if err != nil {
+		t.Fatalf("Error while parsing tag: %v", err)
+	}
+
+	if parsed.Album() != "An album title longer than thi" {
+		t.Errorf("Expected album %q, got %q", "An album title longer than thi", parsed.Album())
+	}
+
+	if truncations := tag.Truncations(); len(truncations) != 1 || truncations[0].FrameID != "TALB" {
+		t.Errorf("Expected 1 truncation of TALB, got %v", truncations)
+	}
+}
+
+// TestWriteOptionsMaxTextLengthsAfterNormalization checks that texts are truncated after Unicode normalization,
+// so the written values fit the limits and match the reported truncations.
+func TestWriteOptionsMaxTextLengthsAfterNormalization(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetDefaultEncoding(EncodingUTF8)
+	tag.SetTitle("éééééééé")
+	tag.SetWriteOptions(WriteOptions{UnicodeNormalization: UnicodeFormNFD, MaxTextLengths: map[string]int{"Title": 5}})
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatalf("Error while writing tag: %v", err)
+	}
+
+	parsed, err := ParseBytes(data, Options{Parse: true})
+	if err != nil {
+		t.Fatalf("Error while parsing tag: %v", err)
+	}
+
+	if title := parsed.Title(); utf8.RuneCountInString(title) != 5 {
+		t.Errorf("Expected a title of 5 runes, got %q", title)
+	}
+
+	truncations := tag.Truncations()
+	if len(truncations) == 0 || truncations[0].Truncated != parsed.Title() {
+		t.Errorf("Expected the truncation of %q to be reported, got %v", parsed.Title(), truncations)
+	}
+}
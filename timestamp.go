@@ -0,0 +1,241 @@
+package id3v2
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidTimestamp is returned when a timestamp doesn't follow the ID3v2.4 format (e.g., "2024-05-17").
+var ErrInvalidTimestamp = errors.New("invalid timestamp")
+
+// TimestampPrecision is the precision of a timestamp stored in a tag,
+// since ID3v2.4 allows to omit the trailing parts, e.g. "2024" or "2024-05".
+type TimestampPrecision int
+
+// Precisions of timestamps.
+const (
+	// PrecisionYear is a year-only timestamp, e.g. "2024".
+	PrecisionYear TimestampPrecision = iota + 1
+	// PrecisionMonth is a year and month timestamp, e.g. "2024-05".
+	PrecisionMonth
+	// PrecisionDay is a date, e.g. "2024-05-17".
+	PrecisionDay
+	// PrecisionHour is a date and hour, e.g. "2024-05-17T20".
+	PrecisionHour
+	// PrecisionMinute is a date and time without seconds, e.g. "2024-05-17T20:15".
+	PrecisionMinute
+	// PrecisionSecond is a full timestamp, e.g. "2024-05-17T20:15:30".
+	PrecisionSecond
+)
+
+// timestampLayouts are the layouts of timestamps indexed by their precision.
+var timestampLayouts = [...]string{
+	PrecisionYear:   "2006",
+	PrecisionMonth:  "2006-01",
+	PrecisionDay:    "2006-01-02",
+	PrecisionHour:   "2006-01-02T15",
+	PrecisionMinute: "2006-01-02T15:04",
+	PrecisionSecond: "2006-01-02T15:04:05",
+}
+
+// Timestamp is a point in time stored in a tag together with its precision,
+// so a year-only date is written back as a year and not expanded to a full timestamp.
+type Timestamp struct {
+	Time      time.Time          // The point in time, the parts beyond the precision are zero.
+	Precision TimestampPrecision // The parts of the time that are set.
+}
+
+// ParseTimestamp parses a timestamp in the ID3v2.4 format: "yyyy", "yyyy-MM", "yyyy-MM-dd",
+// "yyyy-MM-ddTHH", "yyyy-MM-ddTHH:mm" or "yyyy-MM-ddTHH:mm:ss".
+// A space in place of the "T" separator is accepted, as some taggers write it.
+// It returns ErrInvalidTimestamp if the value doesn't match any of them.
+func ParseTimestamp(s string) (Timestamp, error) {
+	s = strings.TrimSpace(s)
+	if len(s) > len("2006-01-02") && s[len("2006-01-02")] == ' ' {
+		s = s[:len("2006-01-02")] + "T" + s[len("2006-01-02")+1:]
+	}
+
+	for precision := PrecisionYear; precision <= PrecisionSecond; precision++ {
+		layout := timestampLayouts[precision]
+		if len(s) != len(layout) {
+			continue
+		}
+
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			return Timestamp{}, fmt.Errorf("%w: %q", ErrInvalidTimestamp, s)
+		}
+
+		return Timestamp{Time: t, Precision: precision}, nil
+	}
+
+	return Timestamp{}, fmt.Errorf("%w: %q", ErrInvalidTimestamp, s)
+}
+
+// IsZero reports whether the timestamp is unset.
+func (ts Timestamp) IsZero() bool {
+	return ts.Precision == 0
+}
+
+// String returns the timestamp in the ID3v2.4 format limited to its precision, e.g. "2024-05".
+// It returns an empty string for an unset timestamp.
+func (ts Timestamp) String() string {
+	if ts.Precision < PrecisionYear || ts.Precision > PrecisionSecond {
+		return ""
+	}
+
+	return ts.Time.Format(timestampLayouts[ts.Precision])
+}
+
+// ReleaseDate returns the date stored in the recording time frame (TDRC), which players show as the release date.
+// For ID3v2.3 tags, it's combined from the year (TYER), date (TDAT) and time (TIME) frames.
+// The precision of the result matches the stored value, e.g. PrecisionYear for "2024".
+// It returns a zero Timestamp if the tag has no date and ErrInvalidTimestamp if the date is malformed.
+func (tag *Tag) ReleaseDate() (Timestamp, error) {
+	if tag.Version() == 4 {
+		return tag.timestamp("TDRC")
+	}
+
+	return tag.v23Timestamp()
+}
+
+// SetReleaseDate sets the date in the recording time frame (TDRC) with exactly the precision of ts,
+// e.g. a year-only date is written as "2024".
+// ID3v2.3 tags store the year, date and time in separate frames (TYER, TDAT and TIME),
+// so only the year, day and minute precisions are kept: the month precision is written as a year,
+// and the hour and second precisions as minutes. A zero ts removes the date.
+func (tag *Tag) SetReleaseDate(ts Timestamp) {
+	if tag.Version() == 4 {
+		tag.setTimestamp("TDRC", ts)
+
+		return
+	}
+
+	tag.DeleteFrames("TYER")
+	tag.DeleteFrames("TDAT")
+	tag.DeleteFrames("TIME")
+
+	if ts.IsZero() {
+		return
+	}
+
+	tag.AddTextFrame("TYER", tag.DefaultEncoding(), ts.Time.Format("2006"))
+
+	if ts.Precision >= PrecisionDay {
+		tag.AddTextFrame("TDAT", tag.DefaultEncoding(), ts.Time.Format("0201"))
+	}
+
+	if ts.Precision >= PrecisionHour {
+		tag.AddTextFrame("TIME", tag.DefaultEncoding(), ts.Time.Format("1504"))
+	}
+}
+
+// timestamp parses the timestamp stored in the text frame with the ID.
+func (tag *Tag) timestamp(id string) (Timestamp, error) {
+	text := tag.GetTextFrame(id).Text
+	if strings.TrimSpace(text) == "" {
+		return Timestamp{}, nil
+	}
+
+	return ParseTimestamp(text)
+}
+
+// setTimestamp stores the timestamp in the text frame with the ID, or removes the frame if ts is zero.
+func (tag *Tag) setTimestamp(id string, ts Timestamp) {
+	if ts.IsZero() {
+		tag.DeleteFrames(id)
+
+		return
+	}
+
+	tag.AddTextFrame(id, tag.DefaultEncoding(), ts.String())
+}
+
+// v23Timestamp combines the ID3v2.3 year (TYER), date (TDAT, "DDMM") and time (TIME, "HHMM") frames.
+func (tag *Tag) v23Timestamp() (Timestamp, error) {
+	year := strings.TrimSpace(tag.GetTextFrame("TYER").Text)
+	if year == "" {
+		return Timestamp{}, nil
+	}
+
+	value := year
+
+	if date := strings.TrimSpace(tag.GetTextFrame("TDAT").Text); len(date) == len("0201") {
+		value += "-" + date[2:] + "-" + date[:2]
+
+		if t := strings.TrimSpace(tag.GetTextFrame("TIME").Text); len(t) == len("1504") {
+			value += "T" + t[:2] + ":" + t[2:]
+		}
+	}
+
+	return ParseTimestamp(value)
+}
+
+// OriginalReleaseDate returns the original release time (TDOR) of a reissued recording.
+// For ID3v2.3 tags, the original release year (TORY) is returned with PrecisionYear.
+// It returns a zero Timestamp if the tag has no date and ErrInvalidTimestamp if the date is malformed.
+func (tag *Tag) OriginalReleaseDate() (Timestamp, error) {
+	if tag.Version() == 4 {
+		return tag.timestamp("TDOR")
+	}
+
+	year := strings.TrimSpace(tag.GetTextFrame("TORY").Text)
+	if year == "" {
+		return Timestamp{}, nil
+	}
+
+	ts, err := ParseTimestamp(year)
+	if err == nil && ts.Precision != PrecisionYear {
+		return Timestamp{}, fmt.Errorf("%w: %q", ErrInvalidTimestamp, year)
+	}
+
+	return ts, err
+}
+
+// SetOriginalReleaseDate sets the original release time (TDOR) with exactly the precision of ts.
+// ID3v2.3 tags store only the original release year (TORY). A zero ts removes the date.
+func (tag *Tag) SetOriginalReleaseDate(ts Timestamp) {
+	if tag.Version() == 4 {
+		tag.setTimestamp("TDOR", ts)
+
+		return
+	}
+
+	if ts.IsZero() {
+		tag.DeleteFrames("TORY")
+
+		return
+	}
+
+	tag.AddTextFrame("TORY", tag.DefaultEncoding(), ts.Time.Format("2006"))
+}
+
+// EncodingTime returns the time the audio was encoded (TDEN).
+// The frame is defined only in ID3v2.4, but it's read from tags of any version.
+// It returns a zero Timestamp if the tag has no date and ErrInvalidTimestamp if the date is malformed.
+func (tag *Tag) EncodingTime() (Timestamp, error) {
+	return tag.timestamp("TDEN")
+}
+
+// SetEncodingTime sets the time the audio was encoded (TDEN) with exactly the precision of ts.
+// The frame is defined only in ID3v2.4, so tag.Validate reports it in ID3v2.3 tags.
+// A zero ts removes the frame.
+func (tag *Tag) SetEncodingTime(ts Timestamp) {
+	tag.setTimestamp("TDEN", ts)
+}
+
+// TaggingTime returns the time the tag was written (TDTG).
+// The frame is defined only in ID3v2.4, but it's read from tags of any version.
+// It returns a zero Timestamp if the tag has no date and ErrInvalidTimestamp if the date is malformed.
+func (tag *Tag) TaggingTime() (Timestamp, error) {
+	return tag.timestamp("TDTG")
+}
+
+// SetTaggingTime sets the time the tag was written (TDTG) with exactly the precision of ts.
+// The frame is defined only in ID3v2.4, so tag.Validate reports it in ID3v2.3 tags.
+// A zero ts removes the frame.
+func (tag *Tag) SetTaggingTime(ts Timestamp) {
+	tag.setTimestamp("TDTG", ts)
+}
@@ -0,0 +1,150 @@
+package id3v2
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		input     string
+		precision TimestampPrecision
+		expected  string
+	}{
+		{"2024", PrecisionYear, "2024"},
+		{"2024-05", PrecisionMonth, "2024-05"},
+		{"2024-05-17", PrecisionDay, "2024-05-17"},
+		{"2024-05-17T20", PrecisionHour, "2024-05-17T20"},
+		{"2024-05-17 20:15", PrecisionMinute, "2024-05-17T20:15"},
+		{" 2024-05-17T20:15:30 ", PrecisionSecond, "2024-05-17T20:15:30"},
+	}
+
+	for _, tc := range testCases {
+		ts, err := ParseTimestamp(tc.input)
+		if err != nil {
+			t.Errorf("Error while parsing %q: %v", tc.input, err)
+
+			continue
+		}
+
+		if ts.Precision != tc.precision || ts.String() != tc.expected {
+			t.Errorf("Expected %q with precision %d, got %q with precision %d",
+				tc.expected, tc.precision, ts.String(), ts.Precision)
+		}
+	}
+
+	for _, input := range []string{"", "24", "2024-13", "2024/05/17", "2024-05-17T20:15:30Z"} {
+		if _, err := ParseTimestamp(input); !errors.Is(err, ErrInvalidTimestamp) {
+			t.Errorf("Expected ErrInvalidTimestamp for %q, got %v", input, err)
+		}
+	}
+}
+
+func TestReleaseDatePrecision(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2024, time.May, 17, 20, 15, 30, 0, time.UTC)
+
+	testCases := []struct {
+		version   byte
+		precision TimestampPrecision
+		expected  string
+	}{
+		{4, PrecisionYear, "2024"},
+		{4, PrecisionMonth, "2024-05"},
+		{4, PrecisionSecond, "2024-05-17T20:15:30"},
+		{3, PrecisionYear, "2024"},
+		{3, PrecisionMonth, "2024"},
+		{3, PrecisionDay, "2024-05-17"},
+		{3, PrecisionSecond, "2024-05-17T20:15"},
+	}
+
+	for _, tc := range testCases {
+		tag := NewEmptyTag()
+		tag.SetVersion(tc.version)
+		tag.SetReleaseDate(Timestamp{Time: date, Precision: tc.precision})
+
+		data, err := tag.Bytes()
+		if err != nil {
+			t.Fatalf("Error while writing tag: %v", err)
+		}
+
+		parsed, err := ParseBytes(data, Options{Parse: true})
+		if err != nil {
+			t.Fatalf("Error while parsing tag: %v", err)
+		}
+
+		ts, err := parsed.ReleaseDate()
+		if err != nil {
+			t.Fatalf("Error while reading release date: %v", err)
+		}
+
+		if ts.String() != tc.expected {
+			t.Errorf("ID3v2.%d, precision %d: expected %q, got %q", tc.version, tc.precision, tc.expected, ts.String())
+		}
+	}
+
+	tag := NewEmptyTag()
+	tag.SetReleaseDate(Timestamp{Time: date, Precision: PrecisionDay})
+	tag.SetReleaseDate(Timestamp{})
+
+	if ts, err := tag.ReleaseDate(); err != nil || !ts.IsZero() {
+		t.Errorf("Expected no release date, got %q (error: %v)", ts, err)
+	}
+}
+
+func TestReleaseEncodingAndTaggingTimes(t *testing.T) {
+	t.Parallel()
+
+	original := Timestamp{Time: time.Date(1977, time.June, 1, 0, 0, 0, 0, time.UTC), Precision: PrecisionMonth}
+	encoded := Timestamp{Time: time.Date(2024, time.May, 17, 20, 15, 0, 0, time.UTC), Precision: PrecisionMinute}
+	tagged := Timestamp{Time: time.Date(2024, time.May, 18, 9, 0, 5, 0, time.UTC), Precision: PrecisionSecond}
+
+	tag := NewEmptyTag()
+	tag.SetOriginalReleaseDate(original)
+	tag.SetEncodingTime(encoded)
+	tag.SetTaggingTime(tagged)
+
+	data, err := tag.Bytes()
+	if err != nil {
+		t.Fatalf("Error while writing tag: %v", err)
+	}
+
+	parsed, err := ParseBytes(data, Options{Parse: true})
+	if err != nil {
+		t.Fatalf("Error while parsing tag: %v", err)
+	}
+
+	getters := []struct {
+		name     string
+		get      func() (Timestamp, error)
+		expected Timestamp
+	}{
+		{"original release date", parsed.OriginalReleaseDate, original},
+		{"encoding time", parsed.EncodingTime, encoded},
+		{"tagging time", parsed.TaggingTime, tagged},
+	}
+
+	for _, g := range getters {
+		ts, err := g.get() //nolint:govet // Shadowing is intended.
+		if err != nil {
+			t.Errorf("Error while reading %s: %v", g.name, err)
+
+			continue
+		}
+
+		if !ts.Time.Equal(g.expected.Time) || ts.Precision != g.expected.Precision {
+			t.Errorf("Expected %s %q, got %q", g.name, g.expected, ts)
+		}
+	}
+
+	tag.SetVersion(3)
+	tag.SetOriginalReleaseDate(original)
+
+	if ts, err := tag.OriginalReleaseDate(); err != nil || ts.String() != "1977" {
+		t.Errorf("Expected original release year %q, got %q (error: %v)", "1977", ts, err)
+	}
+}
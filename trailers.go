@@ -0,0 +1,129 @@
+package id3v2
+
+import (
+	"io"
+	"strconv"
+)
+
+const (
+	// lyrics3BeginMarker is the marker at the beginning of Lyrics3 blocks of both versions.
+	lyrics3BeginMarker = "LYRICSBEGIN"
+
+	// lyrics3v1EndMarker is the marker at the end of a Lyrics3v1 block.
+	lyrics3v1EndMarker = "LYRICSEND"
+
+	// lyrics3v2EndMarker is the marker at the end of a Lyrics3v2 block.
+	lyrics3v2EndMarker = "LYRICS200"
+
+	// lyrics3v2SizeLength is the length of the size field before the Lyrics3v2 end marker.
+	lyrics3v2SizeLength = 6
+
+	// lyrics3v1MaxSize is the maximum size of a Lyrics3v1 block including its markers.
+	lyrics3v1MaxSize = 5100 + len(lyrics3BeginMarker) + len(lyrics3v1EndMarker)
+)
+
+// trailersStart returns the offset where the ID3v1 and Lyrics3 trailers
+// start in the data of size `size` read from `r`.
+// If there are no trailers, it returns `size`.
+func trailersStart(r io.ReaderAt, size int64) (int64, error) {
+	end := size
+
+	hasID3v1, err := hasID3v1Tag(r, end)
+	if err != nil {
+		return 0, err
+	}
+
+	if hasID3v1 {
+		end -= id3v1TagSize
+	}
+
+	lyricsStart, err := lyrics3Start(r, end)
+	if err != nil {
+		return 0, err
+	}
+
+	return lyricsStart, nil
+}
+
+// lyrics3Start returns the offset of a Lyrics3 block that ends at `end`.
+// If there is no Lyrics3 block, it returns `end`.
+func lyrics3Start(r io.ReaderAt, end int64) (int64, error) {
+	// Both versions end with a 9-byte marker.
+	markerLength := int64(len(lyrics3v2EndMarker))
+	if end < markerLength {
+		return end, nil
+	}
+
+	marker := make([]byte, markerLength)
+	if _, err := r.ReadAt(marker, end-markerLength); err != nil {
+		return 0, err
+	}
+
+	switch string(marker) {
+	case lyrics3v2EndMarker:
+		return lyrics3v2Start(r, end-markerLength, end)
+	case lyrics3v1EndMarker:
+		return lyrics3v1Start(r, end-markerLength, end)
+	default:
+		return end, nil
+	}
+}
+
+// lyrics3v2Start returns the offset of a Lyrics3v2 block whose size field ends at `sizeEnd`.
+// The size field holds the size of the block without the size field and the end marker.
+func lyrics3v2Start(r io.ReaderAt, sizeEnd, end int64) (int64, error) {
+	if sizeEnd < lyrics3v2SizeLength {
+		return end, nil
+	}
+
+	sizeField := make([]byte, lyrics3v2SizeLength)
+	if _, err := r.ReadAt(sizeField, sizeEnd-lyrics3v2SizeLength); err != nil {
+		return 0, err
+	}
+
+	blockSize, err := strconv.ParseInt(string(sizeField), 10, 64)
+	if err != nil {
+		return end, nil //nolint:nilerr // A malformed size field means there is no valid block.
+	}
+
+	start := sizeEnd - lyrics3v2SizeLength - blockSize
+	if start < 0 {
+		return end, nil
+	}
+
+	if ok, err := hasLyrics3BeginMarker(r, start); err != nil || !ok {
+		return end, err
+	}
+
+	return start, nil
+}
+
+// lyrics3v1Start returns the offset of a Lyrics3v1 block whose end marker starts at `markerStart`.
+// Lyrics3v1 has no size field, so the begin marker is searched for within the maximum block size.
+func lyrics3v1Start(r io.ReaderAt, markerStart, end int64) (int64, error) {
+	searchStart := max(end-int64(lyrics3v1MaxSize), 0)
+
+	buf := make([]byte, markerStart-searchStart)
+	if _, err := r.ReadAt(buf, searchStart); err != nil {
+		return 0, err
+	}
+
+	// Find the last begin marker before the end marker.
+	for i := len(buf) - len(lyrics3BeginMarker); i >= 0; i-- {
+		if string(buf[i:i+len(lyrics3BeginMarker)]) == lyrics3BeginMarker {
+			return searchStart + int64(i), nil
+		}
+	}
+
+	return end, nil
+}
+
+// hasLyrics3BeginMarker reports whether the Lyrics3 begin marker is located at `offset`.
+func hasLyrics3BeginMarker(r io.ReaderAt, offset int64) (bool, error) {
+	marker := make([]byte, len(lyrics3BeginMarker))
+	if _, err := r.ReadAt(marker, offset); err != nil {
+		return false, err
+	}
+
+	return string(marker) == lyrics3BeginMarker, nil
+}
@@ -0,0 +1,87 @@
+package id3v2
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+)
+
+func TestTrailersStart(t *testing.T) {
+	t.Parallel()
+
+	music := bytes.Repeat([]byte{0xFF}, 256)
+	id3v1 := NewEmptyTag().id3v1Tag().bytes()
+
+	lyrics3v2Body := lyrics3BeginMarker + "IND00002" + "10" + "LYR00005" + "hello"
+	lyrics3v2 := lyrics3v2Body + fmt.Sprintf("%06d", len(lyrics3v2Body)) + lyrics3v2EndMarker
+	lyrics3v1 := lyrics3BeginMarker + "some lyrics" + lyrics3v1EndMarker
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"no trailers", music},
+		{"ID3v1", concat(music, id3v1)},
+		{"Lyrics3v2", concat(music, []byte(lyrics3v2))},
+		{"Lyrics3v2 and ID3v1", concat(music, []byte(lyrics3v2), id3v1)},
+		{"Lyrics3v1 and ID3v1", concat(music, []byte(lyrics3v1), id3v1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			start, err := trailersStart(bytes.NewReader(tt.data), int64(len(tt.data)))
+			if err != nil {
+				t.Fatal("Error while searching trailers:", err)
+			}
+
+			if start != int64(len(music)) {
+				t.Errorf("Expected trailers to start at %v, got %v", len(music), start)
+			}
+		})
+	}
+}
+
+func TestRemoveTagWithStripTrailers(t *testing.T) {
+	tmpFile, err := prepareTestFile("trailers-*.mp3")
+	if err != nil {
+		t.Fatal("Error while preparing test file:", err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	lyrics3v2Body := lyrics3BeginMarker + "LYR00005hello"
+	trailers := concat(
+		[]byte(lyrics3v2Body+fmt.Sprintf("%06d", len(lyrics3v2Body))+lyrics3v2EndMarker),
+		NewEmptyTag().id3v1Tag().bytes(),
+	)
+
+	if _, err = tmpFile.Write(trailers); err != nil {
+		t.Fatal("Error while writing trailers:", err)
+	}
+
+	tmpFile.Close()
+
+	if err = RemoveTagWithOptions(tmpFile.Name(), SaveOptions{StripTrailers: true}); err != nil {
+		t.Fatal("Error while removing tag:", err)
+	}
+
+	stat, err := os.Stat(tmpFile.Name())
+	if err != nil {
+		t.Fatal("Error while getting file stat:", err)
+	}
+
+	if stat.Size() != musicSize {
+		t.Errorf("Expected file size %v, got %v", musicSize, stat.Size())
+	}
+}
+
+func concat(parts ...[]byte) []byte {
+	var b []byte
+	for _, p := range parts {
+		b = append(b, p...)
+	}
+
+	return b
+}
@@ -0,0 +1,97 @@
+package id3v2
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// ttmlRoles maps SYLT content types to TTML roles (ttm:role).
+// Content types without a standard role use the "x-" extension prefix.
+var ttmlRoles = map[SYLTContentType]string{
+	SYLTOtherContentType:             "x-other",
+	SYLTLyricsContentType:            "lyrics",
+	SYLTTextTranscriptionContentType: "transcription",
+	SYLTMovementContentType:          "x-movement",
+	SYLTEventsContentType:            "sound",
+	SYLTChordContentType:             "x-chord",
+	SYLTTriviaContentType:            "x-trivia",
+	SYLTWebpageURLsContentType:       "x-webpage-urls",
+	SYLTImageURLsContentType:         "x-image-urls",
+}
+
+// WriteTTML writes the SYLT frame as a TTML (Timed Text Markup Language) document to w,
+// the format used by streaming services for synced lyrics.
+// The language is converted to a BCP 47 tag (e.g., "eng" becomes "en") and stored in xml:lang,
+// the content descriptor becomes the document title and the content type becomes the ttm:role of the body.
+// Every entry becomes a paragraph ending where the next entry starts. The last paragraph lasts 3 seconds.
+// Returns ErrUnsupportedTimestampFormat if the timestamps aren't in milliseconds.
+func (sylf SynchronisedLyricsFrame) WriteTTML(w io.Writer) error {
+	if sylf.TimestampFormat != SYLTAbsoluteMillisecondsTimestampFormat {
+		return ErrUnsupportedTimestampFormat
+	}
+
+	bw := bufio.NewWriter(w)
+
+	// Write the root element with the language of the lyrics.
+	bw.WriteString(xml.Header)
+	fmt.Fprintf(bw, `<tt xmlns="http://www.w3.org/ns/ttml" xmlns:ttm="http://www.w3.org/ns/ttml#metadata"`+
+		` xml:lang="%s" timeBase="media">`+"\n", ttmlLanguage(sylf.Language))
+
+	// Write the content descriptor as the title of the document.
+	if sylf.ContentDescriptor != "" {
+		fmt.Fprintf(bw, "  <head>\n    <metadata>\n      <ttm:title>%s</ttm:title>\n    </metadata>\n  </head>\n",
+			escapeXML(sylf.ContentDescriptor))
+	}
+
+	role, ok := ttmlRoles[sylf.ContentType]
+	if !ok {
+		role = ttmlRoles[SYLTOtherContentType]
+	}
+
+	fmt.Fprintf(bw, "  <body>\n    <div ttm:role=\"%s\">\n", role)
+
+	for i, st := range sylf.SynchronizedTexts {
+		start, end := cueBounds(sylf.SynchronizedTexts, i)
+
+		// Line breaks within an entry are represented with br elements.
+		text := strings.ReplaceAll(escapeXML(st.Text), "\n", "<br/>")
+
+		fmt.Fprintf(bw, "      <p begin=\"%s\" end=\"%s\">%s</p>\n",
+			formatCueTimestamp(start, '.'), formatCueTimestamp(end, '.'), text)
+	}
+
+	bw.WriteString("    </div>\n  </body>\n</tt>\n")
+
+	return bw.Flush()
+}
+
+// ttmlLanguage converts an ISO 639-2 language code to a BCP 47 language tag.
+// An empty string is returned for unknown codes, which means that the language is undetermined.
+func ttmlLanguage(code string) string {
+	base, err := language.ParseBase(code)
+	if err != nil {
+		return ""
+	}
+
+	return base.String()
+}
+
+// escapeXML escapes the special XML characters in s. Line breaks are kept as is.
+func escapeXML(s string) string {
+	var sb strings.Builder
+
+	for i, line := range strings.Split(s, "\n") {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+
+		xml.EscapeText(&sb, []byte(line)) //nolint:errcheck // strings.Builder never returns an error.
+	}
+
+	return sb.String()
+}
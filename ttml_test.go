@@ -0,0 +1,61 @@
+package id3v2
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestWriteTTML(t *testing.T) {
+	t.Parallel()
+
+	sylf := SynchronisedLyricsFrame{
+		Language:          "eng",
+		TimestampFormat:   SYLTAbsoluteMillisecondsTimestampFormat,
+		ContentType:       SYLTLyricsContentType,
+		ContentDescriptor: "Rock & Roll",
+		SynchronizedTexts: []SynchronizedText{
+			{Text: "Hello <world>\nSecond line", Timestamp: 1000},
+			{Text: "Bye", Timestamp: 2500},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	if err := sylf.WriteTTML(buf); err != nil {
+		t.Fatalf("Error writing TTML: %v", err)
+	}
+
+	document := buf.String()
+
+	for _, expected := range []string{
+		`xml:lang="en"`,
+		`<ttm:title>Rock &amp; Roll</ttm:title>`,
+		`<div ttm:role="lyrics">`,
+		`<p begin="00:00:01.000" end="00:00:02.500">Hello &lt;world&gt;<br/>Second line</p>`,
+		`<p begin="00:00:02.500" end="00:00:05.500">Bye</p>`,
+	} {
+		if !strings.Contains(document, expected) {
+			t.Errorf("Expected TTML to contain %q, got %v", expected, document)
+		}
+	}
+
+	// The document must be well-formed XML.
+	decoder := xml.NewDecoder(strings.NewReader(document))
+	for {
+		if _, err := decoder.Token(); err != nil {
+			if !errors.Is(err, io.EOF) {
+				t.Errorf("Expected well-formed XML, got %v", err)
+			}
+
+			break
+		}
+	}
+
+	sylf.TimestampFormat = SYLTAbsoluteMpegFramesTimestampFormat
+	if err := sylf.WriteTTML(buf); !errors.Is(err, ErrUnsupportedTimestampFormat) {
+		t.Errorf("Expected %v, got %v", ErrUnsupportedTimestampFormat, err)
+	}
+}
@@ -0,0 +1,94 @@
+package id3v2
+
+import (
+	"slices"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// UnicodeForm is a Unicode normalization form applied to the texts of frames on write.
+type UnicodeForm int
+
+// Unicode normalization forms.
+const (
+	// UnicodeFormNone keeps the texts as they are.
+	UnicodeFormNone UnicodeForm = iota
+	// UnicodeFormNFC composes characters, e.g. "e" followed by a combining acute accent becomes "é".
+	// It's the form expected by most players and music servers.
+	UnicodeFormNFC
+	// UnicodeFormNFD decomposes characters, e.g. "é" becomes "e" followed by a combining acute accent.
+	// It's the form used by file names on macOS.
+	UnicodeFormNFD
+)
+
+// normalizer returns the TextNormalizer converting texts to the form, or nil for UnicodeFormNone.
+func (uf UnicodeForm) normalizer() TextNormalizer {
+	switch uf {
+	case UnicodeFormNFC:
+		return norm.NFC.String
+	case UnicodeFormNFD:
+		return norm.NFD.String
+	default:
+		return nil
+	}
+}
+
+// mapFrameTexts returns a copy of the frame with fn applied to all its texts:
+// values, descriptions, comments and lyrics. Other frames are returned as is.
+func mapFrameTexts(frame Framer, fn func(string) string) Framer {
+	mapAll := func(texts []string) []string {
+		if texts == nil {
+			return nil
+		}
+
+		mapped := slices.Clone(texts)
+		for i := range mapped {
+			mapped[i] = fn(mapped[i])
+		}
+
+		return mapped
+	}
+
+	switch f := frame.(type) {
+	case TextFrame:
+		f.Text = fn(f.Text)
+		f.Multi = mapAll(f.Multi)
+
+		return f
+	case UserDefinedTextFrame:
+		f.Description = fn(f.Description)
+		f.Value = fn(f.Value)
+		f.Multi = mapAll(f.Multi)
+
+		return f
+	case CommentFrame:
+		f.Description = fn(f.Description)
+		f.Text = fn(f.Text)
+
+		return f
+	case UnsynchronisedLyricsFrame:
+		f.ContentDescriptor = fn(f.ContentDescriptor)
+		f.Lyrics = fn(f.Lyrics)
+
+		return f
+	case SynchronisedLyricsFrame:
+		f.ContentDescriptor = fn(f.ContentDescriptor)
+
+		f.SynchronizedTexts = slices.Clone(f.SynchronizedTexts)
+		for i := range f.SynchronizedTexts {
+			f.SynchronizedTexts[i].Text = fn(f.SynchronizedTexts[i].Text)
+		}
+
+		return f
+	case PictureFrame:
+		f.Description = fn(f.Description)
+
+		return f
+	case LinkFrame:
+		f.Description = fn(f.Description)
+
+		return f
+	default:
+		return frame
+	}
+}
@@ -0,0 +1,60 @@
+package id3v2
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestUnicodeNormalization(t *testing.T) {
+	t.Parallel()
+
+	const (
+		composed   = "Caf\u00e9"
+		decomposed = "Cafe\u0301"
+	)
+
+	testCases := []struct {
+		form     UnicodeForm
+		input    string
+		expected string
+	}{
+		{UnicodeFormNone, decomposed, decomposed},
+		{UnicodeFormNFC, decomposed, composed},
+		{UnicodeFormNFD, composed, decomposed},
+	}
+
+	for _, tc := range testCases {
+		tag := NewEmptyTag()
+		tag.SetDefaultEncoding(EncodingUTF8)
+		tag.SetTitle(tc.input)
+		tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Description: tc.input, Text: tc.input})
+		tag.SetWriteOptions(WriteOptions{UnicodeNormalization: tc.form})
+
+		var buf bytes.Buffer
+		if _, err := tag.WriteTo(&buf); err != nil {
+			t.Fatalf("Error while writing tag: %v", err)
+		}
+
+		parsed, err := ParseBytes(buf.Bytes(), Options{Parse: true})
+		if err != nil {
+			t.Fatalf("Error while parsing tag: %v", err)
+		}
+
+		if parsed.Title() != tc.expected {
+			t.Errorf("Form %d: expected title %q, got %q", tc.form, tc.expected, parsed.Title())
+		}
+
+		comments := parsed.GetFrames(parsed.CommonID("Comments"))
+		if len(comments) != 1 {
+			t.Fatalf("Form %d: expected 1 comment, got %d", tc.form, len(comments))
+		}
+
+		if cf := comments[0].(CommentFrame); cf.Description != tc.expected || cf.Text != tc.expected {
+			t.Errorf("Form %d: expected comment %q, got %q/%q", tc.form, tc.expected, cf.Description, cf.Text)
+		}
+
+		if tag.Title() != tc.input {
+			t.Errorf("Form %d: expected the stored title to be unchanged, got %q", tc.form, tag.Title())
+		}
+	}
+}
@@ -2,8 +2,8 @@ package id3v2
 
 import (
 	"io"
-	"math/rand/v2"
 	"strconv"
+	"sync/atomic"
 )
 
 // UnknownFrame represents an ID3v2 frame that the library doesn't know how to parse or interpret.
@@ -13,13 +13,16 @@ type UnknownFrame struct {
 	Body []byte // Raw byte data of the unknown frame.
 }
 
+// unknownFrameCounter provides unique identifiers for unknown frames.
+var unknownFrameCounter atomic.Uint64
+
 // UniqueIdentifier generates a unique identifier for the UnknownFrame.
-// Since the frame type is unknown, this method uses a random integer to ensure uniqueness.
+// Since the frame type is unknown, this method uses a sequential number to ensure uniqueness.
 // This is necessary because ID3v2 frames typically have unique identifiers, but unknown frames
 // don't have a predefined ID.
 func (uf UnknownFrame) UniqueIdentifier() string {
-	// Generate a random integer and convert it to a string to ensure uniqueness.
-	return strconv.Itoa(rand.Int())
+	// Take the next number to ensure uniqueness without randomness.
+	return strconv.FormatUint(unknownFrameCounter.Add(1), 10)
 }
 
 // Size returns the size of the UnknownFrame's body in bytes.
@@ -0,0 +1,102 @@
+package id3v2
+
+import (
+	"errors"
+	"io"
+)
+
+// Format flags of ID3v2.4 frame headers.
+// See https://id3.org/id3v2.4.0-structure, section 4.1.2.
+const (
+	frameFlagUnsynchronisation   = 0b0000_0010 // Unsynchronisation is applied on the frame.
+	frameFlagDataLengthIndicator = 0b0000_0001 // The frame body starts with its length before unsynchronisation.
+)
+
+// dataLengthIndicatorSize is the size of the data length indicator of ID3v2.4 frames in bytes.
+const dataLengthIndicatorSize = 4
+
+// unsynchronise applies the unsynchronisation scheme to the data: a zero byte is inserted
+// after every 0xFF byte followed by a byte that could be taken for an MPEG frame sync or by a zero byte,
+// and after a trailing 0xFF byte, so no false sync appears in the tag.
+func unsynchronise(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+
+	for i, b := range data {
+		out = append(out, b)
+
+		if b == 0xFF && (i == len(data)-1 || data[i+1] >= 0xE0 || data[i+1] == 0) {
+			out = append(out, 0)
+		}
+	}
+
+	return out
+}
+
+// synchronisingReader reverses the unsynchronisation scheme of the data read from r
+// by removing the zero bytes following 0xFF bytes.
+type synchronisingReader struct {
+	r io.Reader
+
+	// fill determines whether the removed bytes are appended as zero bytes at the end of the data,
+	// so the data keeps its size and the appended bytes read like padding.
+	fill bool
+
+	afterFF bool  // Whether the last byte read was 0xFF.
+	removed int64 // The number of removed bytes not appended yet.
+	err     error // The error returned by r.
+}
+
+// Read reads the data with the unsynchronisation reversed.
+func (sr *synchronisingReader) Read(p []byte) (int, error) {
+	for {
+		if sr.err != nil {
+			if sr.fill && sr.removed > 0 && errors.Is(sr.err, io.EOF) {
+				n := int(min(int64(len(p)), sr.removed))
+				clear(p[:n])
+				sr.removed -= int64(n)
+
+				return n, nil
+			}
+
+			return 0, sr.err
+		}
+
+		n, err := sr.r.Read(p)
+		sr.err = err
+
+		w := 0
+
+		for _, b := range p[:n] {
+			if sr.afterFF && b == 0 {
+				sr.afterFF = false
+				sr.removed++
+
+				continue
+			}
+
+			sr.afterFF = b == 0xFF
+			p[w] = b
+			w++
+		}
+
+		if w > 0 || (n == 0 && err == nil) {
+			return w, nil
+		}
+	}
+}
+
+// frameBody returns the reader of the body of an ID3v2.4 frame with the format flags,
+// skipping the data length indicator and reversing the unsynchronisation,
+// which is also applied if the tag header says so.
+func frameBody(body io.Reader, formatFlags byte, unsynchronised bool) io.Reader {
+	if formatFlags&frameFlagDataLengthIndicator != 0 {
+		// A body too short for the indicator fails to parse later.
+		_, _ = io.CopyN(io.Discard, body, dataLengthIndicatorSize)
+	}
+
+	if unsynchronised || formatFlags&frameFlagUnsynchronisation != 0 {
+		return &synchronisingReader{r: body}
+	}
+
+	return body
+}
@@ -1,6 +1,9 @@
 package id3v2
 
-import "io"
+import (
+	"io"
+	"strings"
+)
 
 // UserDefinedTextFrame represents a TXXX frame in an ID3v2 tag.
 // TXXX frames are used to store custom, user-defined text information.
@@ -8,17 +11,28 @@ import "io"
 type UserDefinedTextFrame struct {
 	Encoding    Encoding // The text encoding used for the description and value.
 	Description string   // A unique description for this frame (e.g., "My Custom Field").
-	Value       string   // The actual value associated with the description.
-	Multi       []string // A slice of multiple values, if applicable (used for multi-value fields).
+	Value       string   // The actual value associated with the description, the first one of multiple values.
+	Multi       []string // All values of a multi-value frame, starting with Value, empty for a single value.
 }
 
 // Size calculates the total size of the UserDefinedTextFrame in bytes.
-// This includes the encoding byte, the description, termination bytes, and the value.
+// This includes the encoding byte, the description, termination bytes, and the values.
 func (udtf UserDefinedTextFrame) Size() int {
 	return 1 + // 1 byte for the encoding
 		encodedSize(udtf.Description, udtf.Encoding) + // Size of the description
 		len(udtf.Encoding.TerminationBytes) + // Size of the termination bytes
-		encodedSize(udtf.Value, udtf.Encoding) // Size of the value
+		encodedValuesSize(udtf.Values(), udtf.Encoding) // Size of the values
+}
+
+// Values returns all values of the frame: Value followed by the rest of Multi.
+// Value takes precedence over the first element of Multi, so changing Value alone is enough
+// for single-value frames.
+func (udtf UserDefinedTextFrame) Values() []string {
+	if len(udtf.Multi) <= 1 {
+		return []string{udtf.Value}
+	}
+
+	return append([]string{udtf.Value}, udtf.Multi[1:]...)
 }
 
 // UniqueIdentifier returns a string that uniquely identifies this frame.
@@ -29,6 +43,7 @@ func (udtf UserDefinedTextFrame) UniqueIdentifier() string {
 }
 
 // WriteTo writes the UserDefinedTextFrame to the provided io.Writer.
+// Multiple values are separated by termination bytes, as defined by ID3v2.4.
 // It returns the number of bytes written and any error encountered.
 func (udtf UserDefinedTextFrame) WriteTo(w io.Writer) (n int64, err error) {
 	return useBufferedWriter(w, func(bw *bufferedWriter) error {
@@ -44,8 +59,8 @@ func (udtf UserDefinedTextFrame) WriteTo(w io.Writer) (n int64, err error) {
 			return err
 		}
 
-		// Write the value, encoded according to the specified encoding.
-		bw.EncodeAndWriteText(udtf.Value, udtf.Encoding)
+		// Write the values, encoded according to the specified encoding.
+		bw.EncodeAndWriteValues(udtf.Values(), udtf.Encoding)
 
 		return nil
 	})
@@ -95,3 +110,8 @@ func parseUserDefinedTextFrame(br *bufferedReader, _ byte) (Framer, error) {
 
 	return udtf, nil
 }
+
+// normalizeDescription returns the description in a form that ignores case and surrounding spaces.
+func normalizeDescription(description string) string {
+	return strings.ToLower(strings.TrimSpace(description))
+}
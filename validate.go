@@ -0,0 +1,315 @@
+package id3v2
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+// Severity is the severity of a validation issue.
+type Severity int
+
+// Severities of validation issues.
+const (
+	// SeverityWarning marks issues that are allowed by the standard but likely unintended
+	// or poorly supported by players.
+	SeverityWarning Severity = iota
+	// SeverityError marks violations of the ID3v2 standard.
+	SeverityError
+)
+
+// String returns the name of the severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+}
+
+// ValidationIssue describes a single problem found by tag.Validate.
+type ValidationIssue struct {
+	Severity Severity // The severity of the issue.
+	FrameID  string   // The ID of the frame the issue relates to, empty for tag-level issues.
+	Message  string   // A human-readable description of the issue.
+}
+
+// String returns the issue formatted as "severity: frame ID: message".
+func (vi ValidationIssue) String() string {
+	if vi.FrameID == "" {
+		return vi.Severity.String() + ": " + vi.Message
+	}
+
+	return vi.Severity.String() + ": " + vi.FrameID + ": " + vi.Message
+}
+
+var (
+	// v23OnlyFrameIDs are the frame IDs defined in ID3v2.3 but removed in ID3v2.4.
+	v23OnlyFrameIDs = []string{"EQUA", "IPLS", "RVAD", "TDAT", "TIME", "TORY", "TRDA", "TSIZ", "TYER"}
+
+	// v24OnlyFrameIDs are the frame IDs introduced in ID3v2.4.
+	v24OnlyFrameIDs = []string{
+		"ASPI", "EQU2", "RVA2", "SEEK", "SIGN", "TDEN", "TDOR", "TDRC", "TDRL",
+		"TDTG", "TIPL", "TMCL", "TMOO", "TPRO", "TSOA", "TSOP", "TSOT", "TSST",
+	}
+)
+
+// Validate checks the tag against the ID3v2 standard and returns the found issues
+// ordered by frame ID. An empty result means the tag is valid.
+// It checks language codes, encodings supported by the tag's version,
+// duplicate unique identifiers, frame IDs not defined in the tag's version,
+// frames and tags exceeding the maximum size and empty mandatory fields.
+// Pipelines can reject tags with issues of SeverityError and report the rest.
+func (tag *Tag) Validate() []ValidationIssue {
+	var issues []ValidationIssue
+
+	if size := tag.Size(); size > synchSafeMaxSize {
+		issues = append(issues, ValidationIssue{
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("tag size %d exceeds the maximum of %d bytes", size, synchSafeMaxSize),
+		})
+	}
+
+	allFrames := tag.AllFrames()
+	elementIDs := make(map[string]string)
+
+	for _, id := range slices.Sorted(maps.Keys(allFrames)) {
+		issues = append(issues, tag.validateFrameID(id)...)
+
+		seen := make(map[string]bool)
+
+		for _, frame := range allFrames[id] {
+			issues = append(issues, tag.validateFrame(id, frame)...)
+
+			if _, ok := frame.(UnknownFrame); ok {
+				continue
+			}
+
+			uid := frame.UniqueIdentifier()
+			if seen[uid] {
+				issues = append(issues, ValidationIssue{
+					Severity: SeverityError,
+					FrameID:  id,
+					Message:  fmt.Sprintf("duplicate frame with unique identifier %q", uid),
+				})
+			}
+
+			seen[uid] = true
+
+			// Chapters and tables of contents share the namespace of element IDs.
+			if id == "CHAP" || id == "CTOC" {
+				if other, ok := elementIDs[uid]; ok && other != id {
+					issues = append(issues, ValidationIssue{
+						Severity: SeverityError,
+						FrameID:  id,
+						Message:  fmt.Sprintf("element ID %q is already used by a %s frame", uid, other),
+					})
+				}
+
+				elementIDs[uid] = id
+			}
+		}
+	}
+
+	return issues
+}
+
+// ValidateChapters checks the timing of the chapters ordered by start time and returns the found issues:
+// overlapping chapters and chapters ending after the audio duration are errors,
+// gaps between chapters (including before the first one) are warnings.
+// If duration is 0, chapters aren't checked against the audio duration,
+// pass tag.AudioInfo().Duration to check them.
+// Malformed chapter timing silently breaks podcast apps, so it's worth checking before publishing.
+func (tag *Tag) ValidateChapters(duration time.Duration) []ValidationIssue {
+	var issues []ValidationIssue
+
+	id := tag.CommonID("Chapters")
+	addIssue := func(severity Severity, format string, args ...any) {
+		issues = append(issues, ValidationIssue{Severity: severity, FrameID: id, Message: fmt.Sprintf(format, args...)})
+	}
+
+	var previous *ChapterFrame
+
+	for _, cf := range tag.Chapters() {
+		switch {
+		case previous == nil && cf.StartTime > 0:
+			addIssue(SeverityWarning, "gap of %s before chapter %q", cf.StartTime, cf.ElementID)
+		case previous != nil && cf.StartTime < previous.EndTime:
+			addIssue(SeverityError, "chapter %q overlaps chapter %q by %s",
+				cf.ElementID, previous.ElementID, previous.EndTime-cf.StartTime)
+		case previous != nil && cf.StartTime > previous.EndTime:
+			addIssue(SeverityWarning, "gap of %s between chapters %q and %q",
+				cf.StartTime-previous.EndTime, previous.ElementID, cf.ElementID)
+		}
+
+		if duration > 0 && cf.EndTime > duration {
+			addIssue(SeverityError, "chapter %q ends at %s after the end of the audio at %s",
+				cf.ElementID, cf.EndTime, duration)
+		}
+
+		// Keep the chapter that ends last, so chapters nested in a long one aren't reported as gaps.
+		if previous == nil || cf.EndTime > previous.EndTime {
+			previous = &cf
+		}
+	}
+
+	return issues
+}
+
+// validateFrameID checks that the frame ID is well-formed and defined in the tag's version.
+func (tag *Tag) validateFrameID(id string) []ValidationIssue {
+	if !isValidFrameID(id) {
+		return []ValidationIssue{{
+			Severity: SeverityError,
+			FrameID:  id,
+			Message:  "frame ID must consist of 4 uppercase letters or digits",
+		}}
+	}
+
+	var removed []string
+
+	switch tag.Version() {
+	case 3:
+		removed = v24OnlyFrameIDs
+	case 4:
+		removed = v23OnlyFrameIDs
+	}
+
+	if slices.Contains(removed, id) {
+		return []ValidationIssue{{
+			Severity: SeverityError,
+			FrameID:  id,
+			Message:  fmt.Sprintf("frame is not defined in ID3v2.%d", tag.Version()),
+		}}
+	}
+
+	return nil
+}
+
+// validateFrame checks the size, encoding, language and mandatory fields of a single frame.
+func (tag *Tag) validateFrame(id string, frame Framer) []ValidationIssue {
+	var issues []ValidationIssue
+
+	addIssue := func(severity Severity, format string, args ...any) {
+		issues = append(issues, ValidationIssue{Severity: severity, FrameID: id, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if size := frame.Size(); size > synchSafeMaxSize {
+		addIssue(SeverityError, "frame size %d exceeds the maximum of %d bytes", size, synchSafeMaxSize)
+	}
+
+	if encoding, ok := frameEncoding(frame); ok {
+		if tag.Version() == 3 && !encoding.Equals(EncodingISO) && !encoding.Equals(EncodingUTF16) {
+			addIssue(SeverityError, "encoding %s is not supported by ID3v2.3", encoding)
+		}
+	}
+
+	if language, ok := frameLanguage(frame); ok && !IsValidLanguageCode(language) {
+		if normalized, ok := NormalizeLanguageCode(language); ok { //nolint:govet // Shadowing is intended.
+			addIssue(SeverityWarning, "language code %q should be %q", language, normalized)
+		} else {
+			addIssue(SeverityError, "invalid language code %q", language)
+		}
+	}
+
+	switch f := frame.(type) {
+	case TextFrame:
+		if f.Text == "" && len(f.Multi) == 0 {
+			addIssue(SeverityWarning, "text is empty")
+		}
+	case UserDefinedTextFrame:
+		if f.Value == "" && len(f.Multi) == 0 {
+			addIssue(SeverityWarning, "value is empty")
+		}
+	case PictureFrame:
+		if f.MimeType == "" {
+			addIssue(SeverityError, "MIME type is empty")
+		}
+
+		if len(f.Picture) == 0 {
+			addIssue(SeverityError, "picture data is empty")
+		}
+	case UFIDFrame:
+		if f.OwnerIdentifier == "" {
+			addIssue(SeverityError, "owner identifier is empty")
+		}
+
+		if len(f.Identifier) == 0 {
+			addIssue(SeverityError, "identifier is empty")
+		}
+	case LinkFrame:
+		if f.URL == "" {
+			addIssue(SeverityWarning, "URL is empty")
+		}
+	case ChapterFrame:
+		if f.ElementID == "" {
+			addIssue(SeverityError, "element ID is empty")
+		}
+
+		if f.EndTime < f.StartTime {
+			addIssue(SeverityError, "end time %s is before start time %s", f.EndTime, f.StartTime)
+		}
+	case TableOfContentsFrame:
+		if f.ElementID == "" {
+			addIssue(SeverityError, "element ID is empty")
+		}
+	}
+
+	return issues
+}
+
+// frameEncoding returns the text encoding of the frame if it has one.
+func frameEncoding(frame Framer) (Encoding, bool) {
+	switch f := frame.(type) {
+	case TextFrame:
+		return f.Encoding, true
+	case UserDefinedTextFrame:
+		return f.Encoding, true
+	case CommentFrame:
+		return f.Encoding, true
+	case PictureFrame:
+		return f.Encoding, true
+	case LinkFrame:
+		return f.Encoding, true
+	case UnsynchronisedLyricsFrame:
+		return f.Encoding, true
+	case SynchronisedLyricsFrame:
+		return f.Encoding, true
+	default:
+		return Encoding{}, false
+	}
+}
+
+// frameLanguage returns the language code of the frame if it has one.
+func frameLanguage(frame Framer) (string, bool) {
+	switch f := frame.(type) {
+	case CommentFrame:
+		return f.Language, true
+	case UnsynchronisedLyricsFrame:
+		return f.Language, true
+	case SynchronisedLyricsFrame:
+		return f.Language, true
+	default:
+		return "", false
+	}
+}
+
+// isValidFrameID reports whether the ID consists of 4 uppercase ASCII letters or digits.
+func isValidFrameID(id string) bool {
+	if len(id) != 4 {
+		return false
+	}
+
+	for i := range len(id) {
+		c := id[i]
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,88 @@
+package id3v2
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetVersion(3)
+	tag.SetArtist("Artist")
+	tag.AddTextFrame("TDRC", EncodingISO, "2024")
+	tag.AddTextFrame("TALB", EncodingUTF8, "Album")
+	tag.AddTextFrame("TIT2", EncodingISO, "")
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingISO, Language: "english", Text: "Comment"})
+	tag.AddAttachedPicture(PictureFrame{Encoding: EncodingISO, PictureType: PTFrontCover})
+	tag.AddChapterFrame(ChapterFrame{ElementID: "ch1", StartTime: time.Second, EndTime: 0})
+	tag.AddTableOfContentsFrame(TableOfContentsFrame{ElementID: "ch1", TopLevel: true})
+
+	expected := []ValidationIssue{
+		{SeverityError, "APIC", "MIME type is empty"},
+		{SeverityError, "APIC", "picture data is empty"},
+		{SeverityError, "CHAP", "end time 0s is before start time 1s"},
+		{SeverityError, "COMM", `invalid language code "english"`},
+		{SeverityError, "CTOC", `element ID "ch1" is already used by a CHAP frame`},
+		{SeverityError, "TALB", "encoding UTF-8 encoded Unicode is not supported by ID3v2.3"},
+		{SeverityError, "TDRC", "frame is not defined in ID3v2.3"},
+		{SeverityWarning, "TIT2", "text is empty"},
+	}
+
+	issues := tag.Validate()
+	if len(issues) != len(expected) {
+		t.Fatalf("Expected %v issues, got %v: %v", len(expected), len(issues), issues)
+	}
+
+	for i := range expected {
+		if issues[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected[i], issues[i])
+		}
+	}
+}
+
+func TestValidateValidTag(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.SetArtist("Artist")
+	tag.SetTitle("Title")
+	tag.AddTextFrame("TDRC", EncodingUTF8, "2024")
+	tag.AddCommentFrame(CommentFrame{Encoding: EncodingUTF8, Language: "eng", Text: "Comment"})
+
+	if issues := tag.Validate(); len(issues) != 0 {
+		t.Errorf("Expected no issues, got %v", issues)
+	}
+}
+
+func TestValidateChapters(t *testing.T) {
+	t.Parallel()
+
+	tag := NewEmptyTag()
+	tag.AddChapterFrame(ChapterFrame{ElementID: "c1", StartTime: time.Second, EndTime: 10 * time.Second})
+	tag.AddChapterFrame(ChapterFrame{ElementID: "c2", StartTime: 8 * time.Second, EndTime: 20 * time.Second})
+	tag.AddChapterFrame(ChapterFrame{ElementID: "c3", StartTime: 25 * time.Second, EndTime: 40 * time.Second})
+
+	expected := []ValidationIssue{
+		{SeverityWarning, "CHAP", `gap of 1s before chapter "c1"`},
+		{SeverityError, "CHAP", `chapter "c2" overlaps chapter "c1" by 2s`},
+		{SeverityWarning, "CHAP", `gap of 5s between chapters "c2" and "c3"`},
+		{SeverityError, "CHAP", `chapter "c3" ends at 40s after the end of the audio at 30s`},
+	}
+
+	issues := tag.ValidateChapters(30 * time.Second)
+	if len(issues) != len(expected) {
+		t.Fatalf("Expected %v issues, got %v: %v", len(expected), len(issues), issues)
+	}
+
+	for i := range expected {
+		if issues[i] != expected[i] {
+			t.Errorf("Expected %v, got %v", expected[i], issues[i])
+		}
+	}
+
+	if issues = tag.ValidateChapters(0); len(issues) != 3 {
+		t.Errorf("Expected %v issues without duration, got %v", 3, issues)
+	}
+}
@@ -0,0 +1,162 @@
+package id3v2
+
+import "strings"
+
+// Vorbis comment keys that are combined into a single ID3v2 frame ("3/12").
+const (
+	vorbisTrackNumberKey = "TRACKNUMBER"
+	vorbisTrackTotalKey  = "TRACKTOTAL"
+	vorbisDiscNumberKey  = "DISCNUMBER"
+	vorbisDiscTotalKey   = "DISCTOTAL"
+	vorbisCommentKey     = "COMMENT"
+)
+
+// VorbisCommentKeys maps Vorbis comment field names (as used in FLAC and Ogg files and by MusicBrainz Picard)
+// to the keys used by ToMap and ApplyMap: MapKeys names, frame IDs or "TXXX:<description>".
+// Fields that aren't listed here are stored in user-defined text frames named after the field.
+var VorbisCommentKeys = map[string]string{
+	"ALBUM":                      "Album",
+	"ALBUMARTIST":                "AlbumArtist",
+	"ALBUMARTISTSORT":            "TSO2",
+	"ALBUMSORT":                  "TSOA",
+	"ARTIST":                     "Artist",
+	"ARTISTSORT":                 "TSOP",
+	"ASIN":                       "TXXX:ASIN",
+	"BARCODE":                    "TXXX:BARCODE",
+	"BPM":                        "BPM",
+	"CATALOGNUMBER":              "TXXX:CATALOGNUMBER",
+	"COMPOSER":                   "Composer",
+	"COMPOSERSORT":               "TSOC",
+	"CONDUCTOR":                  "Conductor",
+	"COPYRIGHT":                  "Copyright",
+	"DATE":                       "Year",
+	"ENCODEDBY":                  "EncodedBy",
+	"ENCODERSETTINGS":            "TSSE",
+	"GENRE":                      "Genre",
+	"GROUPING":                   "Grouping",
+	"ISRC":                       "ISRC",
+	"KEY":                        "Key",
+	"LABEL":                      "Publisher",
+	"LANGUAGE":                   "Language",
+	"LYRICIST":                   "Lyricist",
+	"MEDIA":                      "TMED",
+	"MOOD":                       "TMOO",
+	"MUSICBRAINZ_ALBUMARTISTID":  "TXXX:MusicBrainz Album Artist Id",
+	"MUSICBRAINZ_ALBUMID":        "TXXX:MusicBrainz Album Id",
+	"MUSICBRAINZ_ARTISTID":       "TXXX:MusicBrainz Artist Id",
+	"MUSICBRAINZ_RELEASEGROUPID": "TXXX:MusicBrainz Release Group Id",
+	"MUSICBRAINZ_RELEASETRACKID": "TXXX:MusicBrainz Release Track Id",
+	"ORIGINALDATE":               "TDOR",
+	"RELEASECOUNTRY":             "TXXX:MusicBrainz Album Release Country",
+	"RELEASESTATUS":              "TXXX:MusicBrainz Album Status",
+	"RELEASETYPE":                "TXXX:MusicBrainz Album Type",
+	"REMIXER":                    "TPE4",
+	"SUBTITLE":                   "Subtitle",
+	"TITLE":                      "Title",
+	"TITLESORT":                  "TSOT",
+}
+
+// ToVorbisComments exports the tag as Vorbis comments using the field names from VorbisCommentKeys.
+// Track and disc numbers stored as "3/12" are split into TRACKNUMBER/TRACKTOTAL and DISCNUMBER/DISCTOTAL,
+// comments become COMMENT and other user-defined text frames use their uppercased descriptions.
+// Text frames without a Vorbis counterpart are not exported.
+func (tag *Tag) ToVorbisComments() map[string][]string {
+	fields := make(map[string]string, len(VorbisCommentKeys))
+	for field, key := range VorbisCommentKeys {
+		fields[key] = field
+	}
+
+	comments := make(map[string][]string)
+
+	for key, values := range tag.ToMap() {
+		switch {
+		case key == "Track":
+			splitVorbisNumber(comments, values, vorbisTrackNumberKey, vorbisTrackTotalKey)
+		case key == "Disc":
+			splitVorbisNumber(comments, values, vorbisDiscNumberKey, vorbisDiscTotalKey)
+		case strings.HasPrefix(key, mapKeyCommentPrefix):
+			comments[vorbisCommentKey] = append(comments[vorbisCommentKey], values...)
+		case fields[key] != "":
+			comments[fields[key]] = append(comments[fields[key]], values...)
+		case strings.HasPrefix(key, mapKeyUserDefinedTextPrefix):
+			field := strings.ToUpper(strings.TrimPrefix(key, mapKeyUserDefinedTextPrefix))
+			comments[field] = append(comments[field], values...)
+		}
+	}
+
+	return comments
+}
+
+// FromVorbisComments imports Vorbis comments (e.g., read from a FLAC file) into the tag.
+// Field names are case-insensitive and mapped with VorbisCommentKeys,
+// unknown fields are stored in user-defined text frames named after the field.
+// TRACKNUMBER/TRACKTOTAL and DISCNUMBER/DISCTOTAL are combined into "3/12" values
+// and COMMENT becomes a comment frame. Frames for fields absent from the map are left untouched.
+func (tag *Tag) FromVorbisComments(comments map[string][]string) {
+	upper := make(map[string][]string, len(comments))
+	for field, values := range comments {
+		upper[strings.ToUpper(field)] = append(upper[strings.ToUpper(field)], values...)
+	}
+
+	m := make(map[string][]string, len(upper))
+
+	for field, values := range upper {
+		switch field {
+		case vorbisTrackNumberKey:
+			m["Track"] = joinVorbisNumber(values, upper[vorbisTrackTotalKey])
+		case vorbisDiscNumberKey:
+			m["Disc"] = joinVorbisNumber(values, upper[vorbisDiscTotalKey])
+		case vorbisTrackTotalKey, vorbisDiscTotalKey:
+			// Totals are combined with the numbers above and can't be stored without them.
+		case vorbisCommentKey:
+			m[mapKeyCommentPrefix] = values
+		default:
+			key, ok := VorbisCommentKeys[field]
+			if !ok {
+				key = mapKeyUserDefinedTextPrefix + field
+			}
+
+			m[key] = values
+		}
+	}
+
+	tag.ApplyMap(m)
+}
+
+// splitVorbisNumber splits values like "3/12" into the number and the total fields.
+func splitVorbisNumber(comments map[string][]string, values []string, numberField, totalField string) {
+	if len(values) == 0 {
+		return
+	}
+
+	number, total, _ := strings.Cut(values[0], "/")
+	if number != "" {
+		comments[numberField] = []string{number}
+	}
+
+	if total != "" {
+		comments[totalField] = []string{total}
+	}
+}
+
+// joinVorbisNumber joins the number and the total fields into a value like "3/12".
+func joinVorbisNumber(numbers, totals []string) []string {
+	var number, total string
+
+	if len(numbers) > 0 {
+		number = numbers[0]
+	}
+
+	if len(totals) > 0 {
+		total = totals[0]
+	}
+
+	switch {
+	case number == "":
+		return nil
+	case total == "":
+		return []string{number}
+	default:
+		return []string{number + "/" + total}
+	}
+}
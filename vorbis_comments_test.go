@@ -0,0 +1,62 @@
+package id3v2
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVorbisCommentsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	comments := map[string][]string{
+		"artist":              {"Artist"},
+		"ALBUMARTIST":         {"Album Artist"},
+		"TRACKNUMBER":         {"3"},
+		"TRACKTOTAL":          {"12"},
+		"DISCNUMBER":          {"1"},
+		"GENRE":               {"Rock", "Pop"},
+		"MUSICBRAINZ_ALBUMID": {"0a1b"},
+		"COMMENT":             {"Nice"},
+		"CUSTOMFIELD":         {"Custom"},
+	}
+
+	tag := NewEmptyTag()
+	tag.FromVorbisComments(comments)
+
+	if tag.Artist() != "Artist" {
+		t.Errorf("Expected artist Artist, got %v", tag.Artist())
+	}
+
+	if got := tag.GetTextFrame("TPE2").Text; got != "Album Artist" {
+		t.Errorf("Expected album artist Album Artist, got %v", got)
+	}
+
+	if got := tag.GetTextFrame("TRCK").Text; got != "3/12" {
+		t.Errorf("Expected track 3/12, got %v", got)
+	}
+
+	m := tag.ToMap()
+	if got := m["TXXX:MusicBrainz Album Id"]; !reflect.DeepEqual(got, []string{"0a1b"}) {
+		t.Errorf("Expected MusicBrainz album ID, got %v", got)
+	}
+
+	if got := m["TXXX:CUSTOMFIELD"]; !reflect.DeepEqual(got, []string{"Custom"}) {
+		t.Errorf("Expected custom field, got %v", got)
+	}
+
+	expected := map[string][]string{
+		"ARTIST":              {"Artist"},
+		"ALBUMARTIST":         {"Album Artist"},
+		"TRACKNUMBER":         {"3"},
+		"TRACKTOTAL":          {"12"},
+		"DISCNUMBER":          {"1"},
+		"GENRE":               {"Rock", "Pop"},
+		"MUSICBRAINZ_ALBUMID": {"0a1b"},
+		"COMMENT":             {"Nice"},
+		"CUSTOMFIELD":         {"Custom"},
+	}
+
+	if got := tag.ToVorbisComments(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
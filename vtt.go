@@ -0,0 +1,62 @@
+package id3v2
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// vttSignature is the signature every WebVTT file starts with.
+const vttSignature = "WEBVTT"
+
+// ErrInvalidVTTSignature is returned when a WebVTT file doesn't start with the "WEBVTT" signature.
+var ErrInvalidVTTSignature = errors.New("WebVTT file must start with WEBVTT")
+
+// ParseVTT reads and parses a WebVTT (.vtt) file from the provided io.Reader.
+// Every cue becomes a synchronized text entry with the cue start time in milliseconds as the timestamp.
+// Cue identifiers, cue settings, NOTE, STYLE and REGION blocks are ignored.
+// The result can be used as SynchronizedTexts of a SYLT frame
+// with the SYLTAbsoluteMillisecondsTimestampFormat timestamp format.
+func ParseVTT(inputReader io.Reader) ([]SynchronizedText, error) {
+	// Read the whole file to check the signature before parsing the cues.
+	data, err := io.ReadAll(inputReader)
+	if err != nil {
+		return nil, err
+	}
+
+	// The signature may be preceded by a BOM and followed by a space, a tab or a line break.
+	signatureLine, _, _ := strings.Cut(strings.TrimPrefix(string(data), "\uFEFF"), "\n")
+	signatureLine = strings.TrimSuffix(signatureLine, "\r")
+
+	if signatureLine != vttSignature &&
+		!strings.HasPrefix(signatureLine, vttSignature+" ") && !strings.HasPrefix(signatureLine, vttSignature+"\t") {
+		return nil, ErrInvalidVTTSignature
+	}
+
+	return parseCues(bytes.NewReader(data))
+}
+
+// WriteVTT writes the SYLT frame as a WebVTT (.vtt) file to w.
+// Every entry becomes a cue ending where the next entry starts. The last cue lasts 3 seconds.
+// Returns ErrUnsupportedTimestampFormat if the timestamps aren't in milliseconds.
+func (sylf SynchronisedLyricsFrame) WriteVTT(w io.Writer) error {
+	if sylf.TimestampFormat != SYLTAbsoluteMillisecondsTimestampFormat {
+		return ErrUnsupportedTimestampFormat
+	}
+
+	bw := bufio.NewWriter(w)
+
+	// Write the signature and the blank line that separates it from the cues.
+	bw.WriteString(vttSignature + "\n\n")
+
+	for i, st := range sylf.SynchronizedTexts {
+		start, end := cueBounds(sylf.SynchronizedTexts, i)
+
+		fmt.Fprintf(bw, "%s --> %s\n%s\n\n", formatCueTimestamp(start, '.'), formatCueTimestamp(end, '.'), st.Text)
+	}
+
+	return bw.Flush()
+}
@@ -0,0 +1,77 @@
+package id3v2
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseVTT(t *testing.T) {
+	t.Parallel()
+
+	vttContent := `WEBVTT - Lyrics
+
+NOTE This is a comment
+
+intro
+00:01.500 --> 00:04.000 align:start
+<v Singer>First line
+Second line
+
+01:02:03.004 --> 01:02:05.000
+Last cue
+`
+
+	texts, err := ParseVTT(strings.NewReader(vttContent))
+	if err != nil {
+		t.Fatalf("Error parsing WebVTT file: %v", err)
+	}
+
+	expected := []SynchronizedText{
+		{Text: "<v Singer>First line\nSecond line", Timestamp: 1500},
+		{Text: "Last cue", Timestamp: 3723004},
+	}
+
+	if !reflect.DeepEqual(texts, expected) {
+		t.Errorf("Expected %v, got %v", expected, texts)
+	}
+
+	_, err = ParseVTT(strings.NewReader("1\n00:01.000 --> 00:02.000\nText\n"))
+	if !errors.Is(err, ErrInvalidVTTSignature) {
+		t.Errorf("Expected %v, got %v", ErrInvalidVTTSignature, err)
+	}
+}
+
+func TestWriteVTT(t *testing.T) {
+	t.Parallel()
+
+	sylf := SynchronisedLyricsFrame{
+		TimestampFormat: SYLTAbsoluteMillisecondsTimestampFormat,
+		SynchronizedTexts: []SynchronizedText{
+			{Text: "Hello", Timestamp: 1000},
+			{Text: "World", Timestamp: 2500},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	if err := sylf.WriteVTT(buf); err != nil {
+		t.Fatalf("Error writing WebVTT: %v", err)
+	}
+
+	expected := "WEBVTT\n\n00:00:01.000 --> 00:00:02.500\nHello\n\n00:00:02.500 --> 00:00:05.500\nWorld\n\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+
+	// The exported file must be parsed back to the same entries.
+	texts, err := ParseVTT(buf)
+	if err != nil {
+		t.Fatalf("Error parsing WebVTT file: %v", err)
+	}
+
+	if !reflect.DeepEqual(texts, sylf.SynchronizedTexts) {
+		t.Errorf("Expected %v, got %v", sylf.SynchronizedTexts, texts)
+	}
+}
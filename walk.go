@@ -0,0 +1,153 @@
+package id3v2
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// walkExtensions are the file extensions processed by Walk.
+var walkExtensions = []string{".mp3", ".dsf", ".dff"}
+
+// tagPool holds tags reused by Walk.
+var tagPool = sync.Pool{
+	New: func() any { return NewEmptyTag() },
+}
+
+// WalkError describes a failure to process a single file in Walk.
+type WalkError struct {
+	Path string // The path of the file or directory.
+	Err  error  // The error that occurred.
+}
+
+// Error returns the error message including the path.
+func (e WalkError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e WalkError) Unwrap() error {
+	return e.Err
+}
+
+// WalkReport holds the result of Walk.
+type WalkReport struct {
+	Processed int         // The number of files for which fn returned no error.
+	Errors    []WalkError // The files and directories that failed, ordered by path.
+}
+
+// Walk walks the file tree rooted at root and calls fn for every MP3, DSF and DFF file
+// with its parsed tag, processing the given number of files concurrently.
+// If workers is less than 1, the number of CPUs is used.
+//
+// Tags and buffers are pooled internally, so the tag must not be used after fn returns.
+// Settings changed by fn (e.g., write options) apply only to the current file.
+// The tag is bound to its file, so fn may call tag.Save.
+//
+// Failures of individual files (opening, parsing or errors returned by fn) and unreadable
+// directories don't stop the walk and are reported in WalkReport.Errors.
+// The returned error is non-nil only if root itself can't be read.
+func Walk(root string, workers int, fn func(path string, tag *Tag) error) (WalkReport, error) {
+	if _, err := os.Stat(root); err != nil {
+		return WalkReport{}, err
+	}
+
+	var (
+		report WalkReport
+		mu     sync.Mutex
+	)
+
+	addError := func(path string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		report.Errors = append(report.Errors, WalkError{Path: path, Err: err})
+	}
+
+	paths := func(yield func(string) bool) {
+		_ = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				addError(path, err)
+
+				return nil
+			}
+
+			if !d.Type().IsRegular() || !isWalkExtension(path) {
+				return nil
+			}
+
+			if !yield(path) {
+				return filepath.SkipAll
+			}
+
+			return nil
+		})
+	}
+
+	processConcurrently(workers, paths, func(path string) {
+		if err := walkFile(path, fn); err != nil {
+			addError(path, err)
+
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		report.Processed++
+	})
+
+	slices.SortFunc(report.Errors, func(a, b WalkError) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return report, nil
+}
+
+// walkFile parses the tag of the file with a pooled tag and calls fn.
+func walkFile(path string, fn func(path string, tag *Tag) error) error {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+
+	tag, _ := tagPool.Get().(*Tag)
+	defer func() {
+		tag.release()
+		tagPool.Put(tag)
+	}()
+
+	if err = tag.Reset(file, Options{Parse: true}); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	// Save reopens the file, so close whatever the tag reads from at the end.
+	defer tag.Close()
+
+	return fn(path, tag)
+}
+
+// release clears everything fn could have changed in the tag, including the settings,
+// so the next file starts with a tag equal to a new empty one. The maps of frames are reused.
+func (tag *Tag) release() {
+	for _, s := range tag.sequences {
+		putSequence(s)
+	}
+
+	frames, sequences := tag.frames, tag.sequences
+	clear(frames)
+	clear(sequences)
+
+	*tag = Tag{frames: frames, sequences: sequences}
+	tag.init(nil, 0, 4)
+}
+
+// isWalkExtension reports whether the path has one of the extensions processed by Walk.
+func isWalkExtension(path string) bool {
+	return slices.Contains(walkExtensions, strings.ToLower(filepath.Ext(path)))
+}
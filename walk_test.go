@@ -0,0 +1,138 @@
+package id3v2
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWalk(t *testing.T) {
+	root := t.TempDir()
+
+	if err := os.Mkdir(filepath.Join(root, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"a.mp3", "b.MP3", filepath.Join("sub", "c.mp3"), filepath.Join("sub", "fail.mp3")} {
+		copyTestFile(t, filepath.Join(root, name))
+	}
+
+	if err := os.WriteFile(filepath.Join(root, "notes.txt"), []byte("not audio"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	errFail := errors.New("fail")
+
+	var calls atomic.Int32
+
+	report, err := Walk(root, 3, func(path string, tag *Tag) error {
+		calls.Add(1)
+
+		if filepath.Base(path) == "fail.mp3" {
+			return errFail
+		}
+
+		tag.SetAlbum("Walked")
+
+		return tag.Save()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if calls.Load() != 4 {
+		t.Errorf("Expected %v calls, got %v", 4, calls.Load())
+	}
+
+	if report.Processed != 3 {
+		t.Errorf("Expected %v processed files, got %v", 3, report.Processed)
+	}
+
+	if len(report.Errors) != 1 || !errors.Is(report.Errors[0], errFail) {
+		t.Fatalf("Expected one %v error, got %v", errFail, report.Errors)
+	}
+
+	tag, err := Open(filepath.Join(root, "sub", "c.mp3"), parseOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tag.Close()
+
+	if tag.Album() != "Walked" {
+		t.Errorf("Expected %v, got %v", "Walked", tag.Album())
+	}
+}
+
+// TestWalkReleasesTags checks that the settings changed by the callback for one file
+// don't leak into the pooled tag passed for the next file.
+func TestWalkReleasesTags(t *testing.T) {
+	root := t.TempDir()
+
+	for _, name := range []string{"a.mp3", "b.mp3", "c.mp3"} {
+		copyTestFile(t, filepath.Join(root, name))
+	}
+
+	report, err := Walk(root, 1, func(path string, tag *Tag) error {
+		if tag.WriteOptions().Padding != 0 || tag.readOnly || tag.err != nil || tag.textNormalizers != nil ||
+			tag.commonIDAliases != nil || tag.sequenceKeys != nil || tag.singlePictureTypes || tag.normalizedTXXX ||
+			tag.popularimeterEmail != "" || tag.truncations != nil {
+			t.Errorf("Expected a clean tag for %s", filepath.Base(path))
+		}
+
+		tag.SetWriteOptions(WriteOptions{Padding: 7, MaxTextLengths: map[string]int{"Title": 1}})
+		tag.SetTextNormalizers(TrimSpace)
+		tag.SetCommonIDAlias("Alias", "TIT2")
+		tag.SetSequenceKey("COMM", func(Framer) string { return "" })
+		tag.SetSinglePictureTypes(true)
+		tag.SetNormalizedDescriptions(true)
+		tag.SetDefaultPopularimeterEmail("walker@example.com")
+
+		if _, err := tag.Bytes(); err != nil {
+			return err
+		}
+
+		tag.SetReadOnly(true)
+		tag.SetTitle("Rejected")
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Processed != 3 {
+		t.Errorf("Expected %v processed files, got %v (%v)", 3, report.Processed, report.Errors)
+	}
+}
+
+func TestWalkMissingRoot(t *testing.T) {
+	t.Parallel()
+
+	_, err := Walk(filepath.Join(t.TempDir(), "missing"), 1, func(string, *Tag) error { return nil })
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected %v, got %v", os.ErrNotExist, err)
+	}
+}
+
+func copyTestFile(t *testing.T, dst string) {
+	t.Helper()
+
+	src, err := os.Open(mp3Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer src.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+
+	if _, err = io.Copy(out, src); err != nil {
+		t.Fatal(err)
+	}
+}